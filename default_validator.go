@@ -4,7 +4,6 @@
 package validate
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/go-openapi/spec"
@@ -73,17 +72,6 @@ func isVisited(path string, visitedSchemas map[string]struct{}) bool {
 	return false
 }
 
-// beingVisited asserts a schema is being visited.
-func (d *defaultValidator) beingVisited(path string) {
-	d.visitedSchemas[path] = struct{}{}
-}
-
-// isVisited tells if a path has already been visited.
-func (d *defaultValidator) isVisited(path string) bool {
-	return isVisited(path, d.visitedSchemas)
-}
-
-//nolint:gocognit // refactor in a forthcoming PR
 func (d *defaultValidator) validateDefaultValueValidAgainstSchema() *Result {
 	// every default value that is specified must validate against the schema for that property
 	// headers, items, parameters, schema
@@ -91,88 +79,86 @@ func (d *defaultValidator) validateDefaultValueValidAgainstSchema() *Result {
 	res := pools.poolOfResults.BorrowResult() // will redeem when merged
 	s := d.SpecValidator
 
-	for method, pathItem := range s.expandedAnalyzer().Operations() {
-		for path, op := range pathItem {
-			// parameters
-			for _, param := range paramHelp.safeExpandedParamsFor(path, method, op.ID, res, s) {
-				if param.Default != nil && param.Required {
-					res.AddWarnings(requiredHasDefaultMsg(param.Name, param.In))
-				}
-
-				// reset explored schemas to get depth-first recursive-proof exploration
-				d.resetVisited()
-
-				// Check simple parameters first
-				// default values provided must validate against their inline definition (no explicit schema)
-				if param.Default != nil && param.Schema == nil {
-					// check param default value is valid
-					red := newParamValidator(&param, s.KnownFormats, d.schemaOptions).Validate(param.Default) //#nosec
-					if red.HasErrorsOrWarnings() {
-						res.AddErrors(defaultValueDoesNotValidateMsg(param.Name, param.In))
-						res.Merge(red)
-					} else if red.wantsRedeemOnMerge {
-						pools.poolOfResults.RedeemResult(red)
-					}
-				}
-
-				// Recursively follows Items and Schemas
-				if param.Items != nil {
-					red := d.validateDefaultValueItemsAgainstSchema(param.Name, param.In, &param, param.Items) //#nosec
-					if red.HasErrorsOrWarnings() {
-						res.AddErrors(defaultValueItemsDoesNotValidateMsg(param.Name, param.In))
-						res.Merge(red)
-					} else if red.wantsRedeemOnMerge {
-						pools.poolOfResults.RedeemResult(red)
-					}
-				}
-
-				if param.Schema != nil {
-					// Validate default value against schema
-					red := d.validateDefaultValueSchemaAgainstSchema(param.Name, param.In, param.Schema)
-					if red.HasErrorsOrWarnings() {
-						res.AddErrors(defaultValueDoesNotValidateMsg(param.Name, param.In))
-						res.Merge(red)
-					} else if red.wantsRedeemOnMerge {
-						pools.poolOfResults.RedeemResult(red)
-					}
-				}
+	s.walkOperations(res, operationVisitor{
+		operation: func(method, path string, op *spec.Operation) {
+			// Empty op.ID means there is no meaningful operation: no need to report a specific message
+			if op.Responses == nil && op.ID != "" {
+				res.AddErrors(FindingAt(operationPointer(method, path), noValidResponseMsg(op.ID)))
 			}
+		},
+		param: func(method, path string, _ *spec.Operation, param *spec.Parameter) {
+			res.Merge(d.validateDefaultInParam(param).locate(s.paramPointer(method, path, param)))
+		},
+		response: func(method, path string, op *spec.Operation, response *spec.Response, responseType string, responseCode int) {
+			red := d.validateDefaultInResponse(response, responseType, responseCode, op.ID)
+			res.Merge(red.locate(responsePointer(method, path, responseType, responseCode)))
+		},
+	})
 
-			if op.Responses != nil {
-				if op.Responses.Default != nil {
-					// Same constraint on default Response
-					res.Merge(d.validateDefaultInResponse(op.Responses.Default, jsonDefault, path, 0, op.ID))
-				}
-				// Same constraint on regular Responses
-				if op.Responses.StatusCodeResponses != nil { // Safeguard
-					for code, r := range op.Responses.StatusCodeResponses {
-						res.Merge(d.validateDefaultInResponse(&r, "response", path, code, op.ID)) //#nosec
-					}
-				}
-			} else if op.ID != "" {
-				// Empty op.ID means there is no meaningful operation: no need to report a specific message
-				res.AddErrors(noValidResponseMsg(op.ID))
-			}
-		}
-	}
 	if s.spec.Spec().Definitions != nil { // Safeguard
 		// reset explored schemas to get depth-first recursive-proof exploration
 		d.resetVisited()
 		for nm, sch := range s.spec.Spec().Definitions {
-			res.Merge(d.validateDefaultValueSchemaAgainstSchema("definitions."+nm, "body", &sch)) //#nosec
+			red := d.validateDefaultValueSchemaAgainstSchema("definitions."+nm, "body", &sch) //#nosec
+			res.Merge(red.locate(definitionPointer(nm)))
 		}
 	}
 	return res
 }
 
-func (d *defaultValidator) validateDefaultInResponse(resp *spec.Response, responseType, path string, responseCode int, operationID string) *Result {
+func (d *defaultValidator) validateDefaultInParam(param *spec.Parameter) *Result {
+	res := pools.poolOfResults.BorrowResult()
 	s := d.SpecValidator
 
-	response, res := responseHelp.expandResponseRef(resp, path, s)
-	if !res.IsValid() {
-		return res
+	if param.Default != nil && param.Required {
+		res.AddWarnings(requiredHasDefaultMsg(param.Name, param.In))
+	}
+
+	// reset explored schemas to get depth-first recursive-proof exploration
+	d.resetVisited()
+
+	// Check simple parameters first
+	// default values provided must validate against their inline definition (no explicit schema)
+	if param.Default != nil && param.Schema == nil {
+		// check param default value is valid
+		red := newParamValidator(param, s.KnownFormats, d.schemaOptions).Validate(param.Default)
+		if red.HasErrorsOrWarnings() {
+			res.AddErrors(defaultValueDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	// Recursively follows Items and Schemas
+	if param.Items != nil {
+		red := d.validateDefaultValueItemsAgainstSchema(param.Name, param.In, param, param.Items)
+		if red.HasErrorsOrWarnings() {
+			res.AddErrors(defaultValueItemsDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	if param.Schema != nil {
+		// Validate default value against schema
+		red := d.validateDefaultValueSchemaAgainstSchema(param.Name, param.In, param.Schema)
+		if red.HasErrorsOrWarnings() {
+			res.AddErrors(defaultValueDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
 	}
 
+	return res
+}
+
+func (d *defaultValidator) validateDefaultInResponse(response *spec.Response, responseType string, responseCode int, operationID string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	s := d.SpecValidator
+
 	responseName, responseCodeAsStr := responseHelp.responseMsgVariants(responseType, responseCode)
 
 	if response.Headers != nil { // Safeguard
@@ -225,51 +211,22 @@ func (d *defaultValidator) validateDefaultInResponse(resp *spec.Response, respon
 }
 
 func (d *defaultValidator) validateDefaultValueSchemaAgainstSchema(path, in string, schema *spec.Schema) *Result {
-	if schema == nil || d.isVisited(path) {
-		// Avoids recursing if we are already done with that check
-		return nil
-	}
-	d.beingVisited(path)
 	res := pools.poolOfResults.BorrowResult()
 	s := d.SpecValidator
 
-	if schema.Default != nil {
-		res.Merge(
-			newSchemaValidator(schema, s.spec.Spec(), path+".default", s.KnownFormats, d.schemaOptions).Validate(schema.Default),
-		)
-	}
-	if schema.Items != nil {
-		if schema.Items.Schema != nil {
-			res.Merge(d.validateDefaultValueSchemaAgainstSchema(path+".items.default", in, schema.Items.Schema))
+	walkSchema(path, ".default", schema, d.visitedSchemas, func(path string, schema *spec.Schema) bool {
+		if schema.Default != nil {
+			res.Merge(
+				newSchemaValidator(schema, s.spec.Spec(), path+".default", s.KnownFormats, d.schemaOptions).Validate(schema.Default),
+			)
 		}
-		// Multiple schemas in items
-		if schema.Items.Schemas != nil { // Safeguard
-			for i, sch := range schema.Items.Schemas {
-				res.Merge(d.validateDefaultValueSchemaAgainstSchema(fmt.Sprintf("%s.items[%d].default", path, i), in, &sch)) //#nosec
-			}
+		if _, err := compileRegexp(schema.Pattern); err != nil {
+			res.AddErrors(invalidPatternInMsg(path, in, schema.Pattern))
 		}
-	}
-	if _, err := compileRegexp(schema.Pattern); err != nil {
-		res.AddErrors(invalidPatternInMsg(path, in, schema.Pattern))
-	}
-	if schema.AdditionalItems != nil && schema.AdditionalItems.Schema != nil {
-		// NOTE: we keep validating values, even though additionalItems is not supported by Swagger 2.0 (and 3.0 as well)
-		res.Merge(d.validateDefaultValueSchemaAgainstSchema(path+".additionalItems", in, schema.AdditionalItems.Schema))
-	}
-	for propName, prop := range schema.Properties {
-		res.Merge(d.validateDefaultValueSchemaAgainstSchema(path+"."+propName, in, &prop)) //#nosec
-	}
-	for propName, prop := range schema.PatternProperties {
-		res.Merge(d.validateDefaultValueSchemaAgainstSchema(path+"."+propName, in, &prop)) //#nosec
-	}
-	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
-		res.Merge(d.validateDefaultValueSchemaAgainstSchema(path+".additionalProperties", in, schema.AdditionalProperties.Schema))
-	}
-	if schema.AllOf != nil {
-		for i, aoSch := range schema.AllOf {
-			res.Merge(d.validateDefaultValueSchemaAgainstSchema(fmt.Sprintf("%s.allOf[%d]", path, i), in, &aoSch)) //#nosec
-		}
-	}
+
+		return true
+	})
+
 	return res
 }
 
@@ -15,6 +15,7 @@
 // Entry points:
 //
 //   - Spec()
+//   - [SpecWithOpts]()
 //   - [NewSpecValidator]()
 //   - [SpecValidator].Validate()
 //
@@ -22,31 +23,112 @@
 //
 //	[x] definition can't declare a property that's already defined by one of its ancestors
 //	[x] definition's ancestor can't be a descendant of the same model
+//	[x] definitions can't require an instance of themselves, through required properties, array items with minItems >= 1
+//	    or allOf: no finite document could satisfy them
 //	[x] path uniqueness: each api path should be non-verbatim (account for path param names) unique per method. Validation can be laxed by disabling StrictPathParamUniqueness.
 //	[x] each security reference should contain only unique scopes
 //	[x] each security scope in a security definition should be unique
 //	[x] parameters in path must be unique
 //	[x] each path parameter must correspond to a parameter placeholder and vice versa
 //	[x] each referenceable definition must have references
-//	[x] each definition property listed in the required array must be defined in the properties of the model
+//	[x] each definition property listed in the required array must be defined in the properties of the model, or by one
+//	    of the schemas it is composed of with allOf, anyOf or oneOf
 //	[x] each parameter should have a unique `name` and `type` combination
 //	[x] each operation should have only 1 parameter of type body
-//	[x] each reference must point to a valid object
+//	[x] each reference must point to a valid object. Every $ref which cannot be resolved is reported with its referrer,
+//	    its target and the reason (document cannot be loaded, JSON pointer not found, circular chain of $ref)
 //	[x] every default value that is specified must validate against the schema for that property
+//	[x] every enum value that is specified must validate against the schema for that property, and enum values must be unique
 //	[x] items property is required for all schemas/definitions of type `array`
 //	[x] path parameters must be declared a required
+//	[x] allowEmptyValue is only valid for query and formData parameters, and path parameters may not be objects
 //	[x] headers must not contain $ref
+//	[x] JSON schema keywords not supported by Swagger 2.0 (e.g. oneOf, anyOf, not, additionalItems) and misplaced keywords
+//	    (e.g. required: true on a property) are explained, with the alternative to use
 //	[x] schema and property examples provided must validate against their respective object's schema
-//	[x] examples provided must validate their schema
+//	[x] examples provided must validate their schema. Response examples are validated for JSON (including +json suffixes),
+//	    YAML and XML media types, and for plain text when the schema is a string
+//	[x] x-example values on non-body parameters, items and headers must validate their definition, once parsed
+//	    according to their type and collectionFormat (reported as warnings)
+//	[x] media types in consumes and produces must be valid
+//	[x] host must be a host name or an IP address with an optional port, basePath must begin with / and may not contain
+//	    path templating, and schemes must be one of http, https, ws or wss
+//	[x] parameters of type file must be in formData, and their operation must consume multipart/form-data or application/x-www-form-urlencoded
+//	[x] type file is not allowed for items, headers and body parameters
+//	[x] response codes must be HTTP status codes or default
+//	[x] response headers must not differ only by case
 //
 // Reported as warnings:
 //
 //	[x] path parameters should not contain any of [{,},\w]
 //	[x] empty path
 //	[x] unused definitions
-//	[x] unsupported validation of examples on non-JSON media types
+//	[x] unsupported validation of examples for other media types, and examples which cannot be parsed
 //	[x] examples in response without schema
 //	[x] readOnly properties should not be required
+//	[x] formData parameters in an operation which does not consume multipart/form-data or application/x-www-form-urlencoded
+//	[x] file responses in an operation which only produces JSON
+//	[x] 204 and 304 responses, and responses to HEAD operations, declaring a schema
+//	[x] operations without any success response
+//	[x] header parameters named Accept, Content-Type or Authorization, body parameters on GET, HEAD or DELETE operations,
+//	    path parameters of type array without a collectionFormat and path parameters with a default value
+//	[x] host containing a path, and schemes only listing http
+//	[x] $ref to the wrong kind of object, e.g. a parameter used as a schema
+//	[x] keywords next to a $ref in schemas, parameters and responses, which are ignored. These are reported as errors with
+//	    StrictRefSiblings. Vendor extensions are not reported with IgnoreRefSiblingExtensions.
+//	[x] ambiguous paths: literal segments captured by a path parameter, paths differing only by a trailing slash or by case. These are reported as errors with StrictPathAmbiguity.
+//
+// Vendor extensions registered with a JSON schema (see [Opts].ExtensionSchemas) must validate their schema, and
+// be declared on the expected kinds of objects. With StrictExtensions, extensions which are not registered are rejected.
+//
+// With the SecurityLint option, common API security smells are reported as warnings: operations without any security
+// requirement, API keys in the query string, the OAuth2 implicit flow, credentials accepted over http or ws,
+// password fields returned in responses and readOnly fields accepted in request bodies.
+//
+// With the UnboundedResourceLint option, request and/or response schemas which do not bound the size of the data
+// are reported as warnings: arrays without maxItems, strings without maxLength (unless a format or an enum bounds them),
+// objects with additionalProperties and without maxProperties, and numbers without minimum or maximum.
+// Parameters, headers and schemas declaring x-unbounded: true are exempted.
+//
+// With the Conventions option, names which do not follow the configured naming conventions (camelCase, PascalCase,
+// snake_case, kebab-case, or consistent within the spec) are reported as warnings, for operationIds, definitions,
+// properties and path segments. Tags used by operations without being declared, or declared twice, are reported as well.
+//
+// With the DocumentationLint option, operations without summary or description, parameters and responses without
+// description, definitions without title or description, info without contact or license, and invalid externalDocs
+// urls are reported as warnings. The completeness of the documentation of every operation and definition is exposed
+// by Result.Completeness.
+//
+// With the DuplicateDefinitions option, definitions which are structurally identical are reported as warnings, with
+// a suggestion of the definition to keep. Titles and descriptions are ignored with DuplicateDefinitionsIgnoreDocs.
+//
+// With the GoSwaggerMode option, constructs which break code generation with go-swagger are reported as well:
+//
+//	[x] definitions, operationIds, parameters of an operation and properties of a schema which map to the same go identifier
+//	[x] invalid values for the x-go-name, x-go-type, x-omitempty and x-nullable extensions
+//	[x] parameters which map to a reserved go keyword (warning)
+//
+// Each of these checks is a [SpecRule] with an ID, e.g. "parameters" or "security-lint", which may be disabled
+// with [SpecValidator].DisableRule or replaced with [SpecValidator].RegisterRule. House rules are registered
+// the same way, e.g. with [NewSpecRule], and are run after the built-in rules of their phase (see [WithPhase]).
+//
+// Rules may be tuned per repository with a YAML or JSON configuration (see [LoadRulesConfig]), which enables or
+// disables rules, changes their severity (error, warning, info, hint or off) globally or for the paths and definitions
+// matching some glob patterns, and sets rule parameters such as naming styles.
+//
+// Findings are reported with a severity: error, warning, info or hint (see [Result.BySeverity]). Only errors make
+// a spec invalid. The WarningsAsErrors option promotes warnings to errors, and the FailureThreshold option
+// sets the weakest severity which fails [Result.AsError] on the result of a [SpecValidator], or [SpecWithOpts].
+//
+// Deliberate findings may be suppressed inline, with the IDs of their rules in an "x-validate-ignore" extension
+// on the object they are about, e.g. "x-validate-ignore": ["unused-references"] on a definition. Findings are
+// located by the JSON pointer of the object they are about (see [FindingAt]), and suppressions apply to the
+// findings located at this object or below it. Suppressions which no longer match any finding are reported
+// as warnings.
+//
+// To adopt stricter rules on a legacy spec gradually, the current findings may be recorded in a baseline file
+// (see [NewBaseline]): with the Baseline option, later validations only report new findings. [DiffResults]
+// compares the findings of two results, e.g. to gate pull requests on new findings only.
 //
 // # Validating a schema
 //
@@ -67,7 +149,6 @@
 // With the current version of this package, the following aspects of swagger are not yet supported:
 //
 //	[ ] errors and warnings are not reported with key/line number in spec
-//	[ ] default values and examples on responses only support application/json producer type
 //	[ ] invalid numeric constraints (such as Minimum, etc..) are not checked except for default and example values
 //	[ ] rules for collectionFormat are not implemented
 //	[ ] no validation rule for polymorphism support (discriminator) [not done here]
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/go-openapi/spec"
+)
+
+// enumValidator validates enum values in a spec.
+// Every member of an enum MUST validate the schema which declares it, and enum members MUST be unique.
+type enumValidator struct {
+	SpecValidator  *SpecValidator
+	visitedSchemas map[string]struct{}
+	schemaOptions  *SchemaValidatorOptions
+}
+
+// Validate validates the enum values declared in the swagger spec.
+func (e *enumValidator) Validate() *Result {
+	errs := pools.poolOfResults.BorrowResult() // will redeem when merged
+
+	if e == nil || e.SpecValidator == nil {
+		return errs
+	}
+	e.resetVisited()
+	errs.Merge(e.validateEnumValueValidAgainstSchema()) // error -
+	return errs
+}
+
+// resetVisited resets the internal state of visited schemas.
+func (e *enumValidator) resetVisited() {
+	if e.visitedSchemas == nil {
+		e.visitedSchemas = make(map[string]struct{})
+
+		return
+	}
+
+	clear(e.visitedSchemas)
+}
+
+func (e *enumValidator) validateEnumValueValidAgainstSchema() *Result {
+	// every enum member that is specified must validate against the schema for that property
+	// headers, items, parameters, schema
+
+	res := pools.poolOfResults.BorrowResult() // will redeem when merged
+	s := e.SpecValidator
+
+	s.walkOperations(res, operationVisitor{
+		param: func(method, path string, _ *spec.Operation, param *spec.Parameter) {
+			res.Merge(e.validateEnumInParam(param).locate(s.paramPointer(method, path, param)))
+		},
+		response: func(method, path string, op *spec.Operation, response *spec.Response, responseType string, responseCode int) {
+			red := e.validateEnumInResponse(response, responseType, responseCode, op.ID)
+			res.Merge(red.locate(responsePointer(method, path, responseType, responseCode)))
+		},
+	})
+
+	if s.spec.Spec().Definitions != nil { // Safeguard
+		// reset explored schemas to get depth-first recursive-proof exploration
+		e.resetVisited()
+		for nm, sch := range s.spec.Spec().Definitions {
+			red := e.validateEnumValueSchemaAgainstSchema("definitions."+nm, "body", &sch) //#nosec
+			res.Merge(red.locate(definitionPointer(nm)))
+		}
+	}
+	return res
+}
+
+func (e *enumValidator) validateEnumInParam(param *spec.Parameter) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	s := e.SpecValidator
+
+	// reset explored schemas to get depth-first recursive-proof exploration
+	e.resetVisited()
+
+	// Check simple parameters first
+	// enum values provided must validate against their inline definition (no explicit schema)
+	if len(param.Enum) > 0 && param.Schema == nil {
+		red := e.validateEnumMembers(param.Enum, func(_ int, member any) *Result {
+			return newParamValidator(param, s.KnownFormats, e.schemaOptions).Validate(member)
+		})
+		red.Merge(duplicateEnumValues(param.Name, param.In, param.Enum))
+		if red.HasErrorsOrWarnings() {
+			res.AddErrors(enumValueDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	// Recursively follows Items and Schemas
+	if param.Items != nil {
+		red := e.validateEnumValueItemsAgainstSchema(param.Name, param.In, param, param.Items)
+		if red.HasErrorsOrWarnings() {
+			res.AddErrors(enumValueItemsDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	if param.Schema != nil {
+		// Validate enum values against schema
+		red := e.validateEnumValueSchemaAgainstSchema(param.Name, param.In, param.Schema)
+		if red.HasErrorsOrWarnings() {
+			res.AddErrors(enumValueDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	return res
+}
+
+func (e *enumValidator) validateEnumInResponse(response *spec.Response, responseType string, responseCode int, operationID string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	s := e.SpecValidator
+
+	responseName, responseCodeAsStr := responseHelp.responseMsgVariants(responseType, responseCode)
+
+	if response.Headers != nil { // Safeguard
+		for nm, h := range response.Headers {
+			// reset explored schemas to get depth-first recursive-proof exploration
+			e.resetVisited()
+
+			if len(h.Enum) > 0 {
+				red := e.validateEnumMembers(h.Enum, func(_ int, member any) *Result {
+					return newHeaderValidator(nm, &h, s.KnownFormats, e.schemaOptions).Validate(member) //#nosec
+				})
+				red.Merge(duplicateEnumValues(nm, "header", h.Enum))
+				if red.HasErrorsOrWarnings() {
+					res.AddErrors(enumValueHeaderDoesNotValidateMsg(operationID, nm, responseName))
+					res.Merge(red)
+				} else if red.wantsRedeemOnMerge {
+					pools.poolOfResults.RedeemResult(red)
+				}
+			}
+
+			// Headers have inline definition, like params
+			if h.Items != nil {
+				red := e.validateEnumValueItemsAgainstSchema(nm, "header", &h, h.Items) //#nosec
+				if red.HasErrorsOrWarnings() {
+					res.AddErrors(enumValueHeaderItemsDoesNotValidateMsg(operationID, nm, responseName))
+					res.Merge(red)
+				} else if red.wantsRedeemOnMerge {
+					pools.poolOfResults.RedeemResult(red)
+				}
+			}
+
+			// Headers don't have schema
+		}
+	}
+	if response.Schema != nil {
+		// reset explored schemas to get depth-first recursive-proof exploration
+		e.resetVisited()
+
+		red := e.validateEnumValueSchemaAgainstSchema(responseCodeAsStr, "response", response.Schema)
+		if red.HasErrorsOrWarnings() {
+			// Additional message to make sure the context of the error is not lost
+			res.AddErrors(enumValueInDoesNotValidateMsg(operationID, responseName))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+	return res
+}
+
+// validateEnumValueSchemaAgainstSchema validates the enum members of a schema and of the schemas it contains.
+// The members of the enum of items are reported at the path of the items, e.g. "body.items.enum".
+func (e *enumValidator) validateEnumValueSchemaAgainstSchema(path, in string, schema *spec.Schema) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	s := e.SpecValidator
+
+	walkSchema(path, "", schema, e.visitedSchemas, func(path string, schema *spec.Schema) bool {
+		if len(schema.Enum) > 0 {
+			res.Merge(e.validateEnumMembers(schema.Enum, func(_ int, member any) *Result {
+				return newSchemaValidator(schema, s.spec.Spec(), path+".enum", s.KnownFormats, e.schemaOptions).Validate(member)
+			}))
+			res.Merge(duplicateEnumValues(path, in, schema.Enum))
+		}
+
+		return true
+	})
+
+	return res
+}
+
+func (e *enumValidator) validateEnumValueItemsAgainstSchema(path, in string, root any, items *spec.Items) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	s := e.SpecValidator
+	if items != nil {
+		if len(items.Enum) > 0 {
+			res.Merge(e.validateEnumMembers(items.Enum, func(i int, member any) *Result {
+				return newItemsValidator(path, in, items, root, s.KnownFormats, e.schemaOptions).Validate(i, member)
+			}))
+			res.Merge(duplicateEnumValues(path+".items", in, items.Enum))
+		}
+		if items.Items != nil {
+			res.Merge(e.validateEnumValueItemsAgainstSchema(path+"[0].enum", in, root, items.Items))
+		}
+	}
+	return res
+}
+
+// validateEnumMembers validates each enum member with a fresh validator.
+func (e *enumValidator) validateEnumMembers(enum []any, validate func(int, any) *Result) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	for i, member := range enum {
+		res.Merge(validate(i, member))
+	}
+
+	return res
+}
+
+// duplicateEnumValues reports enum members which appear more than once.
+//
+// Members are compared as unmarshalled from JSON, so 1 and 1.0 are considered duplicates.
+func duplicateEnumValues(path, in string, enum []any) *Result {
+	var dups []any
+	for i, member := range enum {
+		for j := range i {
+			if reflect.DeepEqual(member, enum[j]) {
+				dups = append(dups, member)
+				break
+			}
+		}
+	}
+
+	if len(dups) == 0 {
+		return nil
+	}
+
+	res := pools.poolOfResults.BorrowResult()
+	res.AddErrors(duplicateEnumValuesMsg(path, in, dups))
+
+	return res
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+)
+
+func TestEnumValidator_Nil(t *testing.T) {
+	var e *enumValidator
+	res := e.Validate()
+	assert.TrueT(t, res.IsValid())
+	assert.Empty(t, res.Warnings)
+}
+
+func TestEnumValidator_ValidateEnums(t *testing.T) {
+	t.Run("should accept valid enums", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-enums-good.yaml")
+		assert.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	res, _ := loadFixtureAndValidate(t, "fixture-enums.yaml")
+	assert.FalseT(t, res.IsValid())
+	errs := verifiedTestErrors(res)
+
+	t.Run("should report enum members which do not validate their schema", func(t *testing.T) {
+		assert.SliceContainsT(t, errs, "enum values for q in query do not validate their schema")
+		assert.SliceContainsT(t, errs, "enum values for tags.items in query do not validate their schema")
+		assert.SliceContainsT(t, errs, `in operation "getA", enum values in header X-Mode for response 200 do not validate their schema`)
+		assert.SliceContainsT(t, errs, "definitions.pet.status.enum in body should be at least 2 chars long")
+		assert.SliceContainsT(t, errs, `definitions.pet.codes.items.enum in body must be of type integer: "string"`)
+	})
+
+	t.Run("should report duplicate enum members", func(t *testing.T) {
+		assert.SliceContainsT(t, errs, "enum for n in query contains duplicate values: [1]")
+		assert.SliceContainsT(t, errs, "enum for definitions.order.status in body contains duplicate values: [sold]")
+	})
+}
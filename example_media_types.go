@@ -0,0 +1,425 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/xml"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag/jsonutils"
+	yaml "go.yaml.in/yaml/v3"
+)
+
+type exampleError string
+
+func (e exampleError) Error() string {
+	return string(e)
+}
+
+const (
+	// errExampleMediaTypeNotSupported indicates an example for a media type which cannot be decoded.
+	errExampleMediaTypeNotSupported exampleError = "media type not supported for examples"
+
+	// errEmptyXMLExample indicates an XML example without any root element.
+	errEmptyXMLExample exampleError = "no XML root element found"
+
+	// errMultipleXMLRoots indicates an XML example with more than one root element.
+	errMultipleXMLRoots exampleError = "more than one XML root element found"
+
+	// errXMLRootName indicates an XML example which root element does not match the name expected by the schema.
+	errXMLRootName exampleError = "unexpected XML root element"
+
+	// errXMLExampleNotText indicates an XML example which is not provided as a string.
+	errXMLExampleNotText exampleError = "XML examples should be provided as a string"
+)
+
+// validateResponseExamples validates the examples of a response against its schema.
+//
+// Examples are decoded according to their media type:
+//
+//   - JSON media types, including structured syntax suffixes such as application/problem+json, are validated as is
+//   - YAML examples provided as a string are parsed
+//   - XML examples are parsed, using the xml hints of the schema (name, namespace, attribute, wrapped)
+//   - plain text examples are validated when the schema is a string
+//
+// Examples for other media types are not validated and a warning is reported.
+func (ex *exampleValidator) validateResponseExamples(path, operationID, responseName string, response *spec.Response) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	s := ex.SpecValidator
+
+	mediaTypes := make([]string, 0, len(response.Examples))
+	for mediaType := range response.Examples {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+
+	for _, mediaType := range mediaTypes {
+		example, err := decodeExample(mediaType, response.Examples[mediaType], response.Schema, s.spec.Spec())
+		switch {
+		case stderrors.Is(err, errExampleMediaTypeNotSupported):
+			res.AddWarnings(examplesMimeNotSupportedMsg(mediaType, operationID, responseName))
+		case err != nil:
+			res.AddWarnings(exampleMediaTypeParseMsg(operationID, responseName, mediaType, err))
+		default:
+			res.MergeAsWarnings(
+				newSchemaValidator(response.Schema, s.spec.Spec(), path+".examples", s.KnownFormats, s.schemaOptions).Validate(example),
+			)
+		}
+	}
+
+	return res
+}
+
+// decodeExample decodes an example provided for some media type into a value which may be validated against
+// the schema.
+//
+// JSON and YAML examples which are not provided as a string are assumed to be already decoded. XML examples
+// should always be provided as a string.
+func decodeExample(mediaType string, example any, schema *spec.Schema, root any) (any, error) {
+	switch {
+	case isJSONMediaType(mediaType):
+		return example, nil
+
+	case isYAMLMediaType(mediaType):
+		text, isText := example.(string)
+		if !isText {
+			return example, nil
+		}
+
+		return decodeYAMLExample(text)
+
+	case isXMLMediaType(mediaType):
+		text, isText := example.(string)
+		if !isText {
+			return nil, errXMLExampleNotText
+		}
+
+		return decodeXMLExample(text, schema, root)
+
+	case normalizedMediaType(mediaType) == mediaTypeTextPlain:
+		if sch := resolvedSchema(schema, root); sch == nil || !sch.Type.Contains(stringType) {
+			return nil, errExampleMediaTypeNotSupported
+		}
+
+		return example, nil
+
+	default:
+		return nil, errExampleMediaTypeNotSupported
+	}
+}
+
+// decodeYAMLExample parses a YAML example and normalizes it as if it had been unmarshalled from JSON.
+func decodeYAMLExample(text string) (any, error) {
+	var doc any
+	if err := yaml.Unmarshal([]byte(text), &doc); err != nil {
+		return nil, err
+	}
+
+	var example any
+	if err := jsonutils.FromDynamicJSON(normalizeYAML(doc), &example); err != nil {
+		return nil, err
+	}
+
+	return example, nil
+}
+
+// normalizeYAML converts YAML mappings with non-string keys, so they may be marshalled as JSON.
+func normalizeYAML(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			v[key] = normalizeYAML(val)
+		}
+
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = normalizeYAML(val)
+		}
+
+		return m
+	case []any:
+		for i, val := range v {
+			v[i] = normalizeYAML(val)
+		}
+
+		return v
+	default:
+		return value
+	}
+}
+
+// xmlElement is a parsed XML element, with its attributes, child elements and character data.
+type xmlElement struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*xmlElement
+	text     string
+}
+
+// decodeXMLExample parses an XML example into a value shaped after the schema.
+func decodeXMLExample(text string, schema *spec.Schema, root any) (any, error) {
+	element, err := parseXMLExample(text)
+	if err != nil {
+		return nil, err
+	}
+
+	d := xmlExampleDecoder{root: root}
+	sch := d.resolve(schema)
+	if sch != nil && sch.XML != nil && sch.XML.Name != "" && !xmlNameMatches(element.name, sch.XML.Name, sch.XML) {
+		return nil, fmt.Errorf("%w: expected %q but got %q", errXMLRootName, sch.XML.Name, element.name.Local)
+	}
+
+	return d.decodeElement(element, sch), nil
+}
+
+func parseXMLExample(text string) (*xmlElement, error) {
+	decoder := xml.NewDecoder(strings.NewReader(text))
+
+	var (
+		root  *xmlElement
+		stack []*xmlElement
+	)
+
+	for {
+		token, err := decoder.Token()
+		if stderrors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &xmlElement{name: t.Name, attrs: t.Attr}
+			switch {
+			case len(stack) > 0:
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			case root != nil:
+				return nil, errMultipleXMLRoots
+			default:
+				root = element
+			}
+			stack = append(stack, element)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errEmptyXMLExample
+	}
+
+	return root, nil
+}
+
+// xmlExampleDecoder decodes XML elements following the structure and xml hints of a schema.
+type xmlExampleDecoder struct {
+	root any
+}
+
+func (d xmlExampleDecoder) resolve(schema *spec.Schema) *spec.Schema {
+	return resolvedSchema(schema, d.root)
+}
+
+func (d xmlExampleDecoder) decodeElement(element *xmlElement, schema *spec.Schema) any {
+	switch {
+	case schema == nil:
+		return decodeUntypedXML(element)
+	case schema.Type.Contains(arrayType):
+		return d.decodeItems(element.children, schema.Items)
+	case schema.Type.Contains(objectType) || len(schema.Properties) > 0:
+		return d.decodeObject(element, schema)
+	default:
+		return xmlScalar(strings.TrimSpace(element.text), schema)
+	}
+}
+
+func (d xmlExampleDecoder) decodeItems(elements []*xmlElement, items *spec.SchemaOrArray) []any {
+	values := make([]any, 0, len(elements))
+	for i, element := range elements {
+		var itemSchema *spec.Schema
+		switch {
+		case items == nil:
+		case items.Schema != nil:
+			itemSchema = items.Schema
+		case i < len(items.Schemas):
+			itemSchema = &items.Schemas[i]
+		}
+
+		values = append(values, d.decodeElement(element, d.resolve(itemSchema)))
+	}
+
+	return values
+}
+
+func (d xmlExampleDecoder) decodeObject(element *xmlElement, schema *spec.Schema) map[string]any {
+	object := make(map[string]any, len(schema.Properties))
+	claimed := make(map[*xmlElement]bool, len(element.children))
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propSchema := schema.Properties[name]
+		prop := d.resolve(&propSchema)
+		if prop == nil {
+			// unresolved or circular $ref: the property is decoded untyped
+			for _, child := range element.children {
+				if !claimed[child] && xmlNameMatches(child.name, name, nil) {
+					claimed[child] = true
+					object[name] = decodeUntypedXML(child)
+
+					break
+				}
+			}
+
+			continue
+		}
+
+		elementName := name
+		if prop.XML != nil && prop.XML.Name != "" {
+			elementName = prop.XML.Name
+		}
+
+		switch {
+		case prop.XML != nil && prop.XML.Attribute:
+			for _, attr := range element.attrs {
+				if xmlNameMatches(attr.Name, elementName, prop.XML) {
+					object[name] = xmlScalar(attr.Value, prop)
+
+					break
+				}
+			}
+
+		case prop.Type.Contains(arrayType) && prop.XML != nil && prop.XML.Wrapped:
+			for _, child := range element.children {
+				if !claimed[child] && xmlNameMatches(child.name, elementName, prop.XML) {
+					claimed[child] = true
+					object[name] = d.decodeItems(child.children, prop.Items)
+
+					break
+				}
+			}
+
+		case prop.Type.Contains(arrayType):
+			// unwrapped arrays repeat the item element, named after the items if specified
+			itemName, itemXML := elementName, prop.XML
+			if prop.Items != nil && prop.Items.Schema != nil {
+				if items := d.resolve(prop.Items.Schema); items != nil && items.XML != nil && items.XML.Name != "" {
+					itemName, itemXML = items.XML.Name, items.XML
+				}
+			}
+
+			var matches []*xmlElement
+			for _, child := range element.children {
+				if !claimed[child] && xmlNameMatches(child.name, itemName, itemXML) {
+					claimed[child] = true
+					matches = append(matches, child)
+				}
+			}
+			if len(matches) > 0 {
+				object[name] = d.decodeItems(matches, prop.Items)
+			}
+
+		default:
+			for _, child := range element.children {
+				if !claimed[child] && xmlNameMatches(child.name, elementName, prop.XML) {
+					claimed[child] = true
+					object[name] = d.decodeElement(child, prop)
+
+					break
+				}
+			}
+		}
+	}
+
+	// elements not matching any property are left to additionalProperties
+	var additional *spec.Schema
+	if schema.AdditionalProperties != nil {
+		additional = d.resolve(schema.AdditionalProperties.Schema)
+	}
+	for _, child := range element.children {
+		if claimed[child] {
+			continue
+		}
+		if _, exists := object[child.name.Local]; !exists {
+			object[child.name.Local] = d.decodeElement(child, additional)
+		}
+	}
+
+	return object
+}
+
+// decodeUntypedXML decodes an element without a schema: elements without children are decoded as strings,
+// other elements as objects. Repeated child elements are decoded as arrays.
+func decodeUntypedXML(element *xmlElement) any {
+	if len(element.children) == 0 {
+		return strings.TrimSpace(element.text)
+	}
+
+	object := make(map[string]any, len(element.children))
+	for _, child := range element.children {
+		value := decodeUntypedXML(child)
+		switch existing := object[child.name.Local].(type) {
+		case nil:
+			object[child.name.Local] = value
+		case []any:
+			object[child.name.Local] = append(existing, value)
+		default:
+			object[child.name.Local] = []any{existing, value}
+		}
+	}
+
+	return object
+}
+
+// xmlScalar converts character data to the type of the schema. Values which cannot be converted
+// are left as strings, so the schema validation reports them.
+func xmlScalar(text string, schema *spec.Schema) any {
+	switch {
+	case schema.Type.Contains(integerType):
+		if v, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return v
+		}
+	case schema.Type.Contains(numberType):
+		if v, err := strconv.ParseFloat(text, 64); err == nil {
+			return v
+		}
+	case schema.Type.Contains(booleanType):
+		if v, err := strconv.ParseBool(text); err == nil {
+			return v
+		}
+	}
+
+	return text
+}
+
+// xmlNameMatches tells if an XML name matches the expected local name. When the xml hint specifies a namespace,
+// the namespace must match as well.
+func xmlNameMatches(name xml.Name, local string, hint *spec.XMLObject) bool {
+	if name.Local != local {
+		return false
+	}
+
+	return hint == nil || hint.Namespace == "" || name.Space == hint.Namespace
+}
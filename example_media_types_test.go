@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestMediaTypes_Kinds(t *testing.T) {
+	for _, mt := range []string{"application/json", "application/problem+json", "application/hal+json; charset=utf-8"} {
+		assert.TrueTf(t, isJSONMediaType(mt), "expected %q to be a JSON media type", mt)
+	}
+	for _, mt := range []string{"application/yaml", "application/x-yaml", "text/yaml", "application/vnd.api+yaml"} {
+		assert.TrueTf(t, isYAMLMediaType(mt), "expected %q to be a YAML media type", mt)
+	}
+	for _, mt := range []string{"application/xml", "text/xml; charset=utf-8", "application/atom+xml"} {
+		assert.TrueTf(t, isXMLMediaType(mt), "expected %q to be an XML media type", mt)
+	}
+	for _, mt := range []string{"text/plain", "image/png", "application/jsonl"} {
+		assert.FalseTf(t, isJSONMediaType(mt) || isYAMLMediaType(mt) || isXMLMediaType(mt),
+			"expected %q not to be a structured media type", mt)
+	}
+}
+
+func TestDecodeExample(t *testing.T) {
+	var schema spec.Schema
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "object",
+		"xml": {"name": "pet", "namespace": "https://example.com/pets"},
+		"properties": {
+			"id": {"type": "integer", "xml": {"attribute": true}},
+			"name": {"type": "string"},
+			"alive": {"type": "boolean", "xml": {"name": "isAlive"}},
+			"tags": {"type": "array", "xml": {"wrapped": true}, "items": {"type": "string", "xml": {"name": "tag"}}},
+			"photos": {"type": "array", "items": {"type": "string", "xml": {"name": "photo"}}}
+		}
+	}`), &schema))
+
+	t.Run("should decode XML examples following xml hints", func(t *testing.T) {
+		example, err := decodeExample("application/xml", `<pet xmlns="https://example.com/pets" id="12">
+			<name>Rex</name>
+			<isAlive>true</isAlive>
+			<tags><tag>dog</tag><tag>good</tag></tags>
+			<photo>a.png</photo>
+			<photo>b.png</photo>
+			<color>brown</color>
+		</pet>`, &schema, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"id":     int64(12),
+			"name":   "Rex",
+			"alive":  true,
+			"tags":   []any{"dog", "good"},
+			"photos": []any{"a.png", "b.png"},
+			"color":  "brown",
+		}, example)
+	})
+
+	t.Run("should not match elements from another namespace", func(t *testing.T) {
+		example, err := decodeExample("text/xml", `<pet xmlns="https://example.com/pets" xmlns:o="urn:other"><o:name>Rex</o:name></pet>`, &schema, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "Rex"}, example)
+	})
+
+	t.Run("should reject XML examples with an unexpected root element", func(t *testing.T) {
+		_, err := decodeExample("application/xml", `<dog xmlns="https://example.com/pets"/>`, &schema, nil)
+		require.ErrorIs(t, err, errXMLRootName)
+	})
+
+	t.Run("should reject malformed XML examples", func(t *testing.T) {
+		_, err := decodeExample("application/xml", `<pet><name>Rex</pet>`, &schema, nil)
+		require.Error(t, err)
+
+		_, err = decodeExample("application/xml", `<pet/><pet/>`, &schema, nil)
+		require.ErrorIs(t, err, errMultipleXMLRoots)
+
+		_, err = decodeExample("application/xml", ``, &schema, nil)
+		require.ErrorIs(t, err, errEmptyXMLExample)
+
+		_, err = decodeExample("application/xml", map[string]any{"name": "Rex"}, &schema, nil)
+		require.ErrorIs(t, err, errXMLExampleNotText)
+	})
+
+	t.Run("should decode YAML examples", func(t *testing.T) {
+		example, err := decodeExample("application/x-yaml", "id: 12\nname: Rex\ntags: [dog, good]\n1: one\n", &schema, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"id":   float64(12),
+			"name": "Rex",
+			"tags": []any{"dog", "good"},
+			"1":    "one",
+		}, example)
+	})
+
+	t.Run("should only decode plain text examples for string schemas", func(t *testing.T) {
+		example, err := decodeExample("text/plain; charset=utf-8", "hello", &spec.Schema{SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{stringType}}}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", example)
+
+		_, err = decodeExample("text/plain", "hello", &schema, nil)
+		require.ErrorIs(t, err, errExampleMediaTypeNotSupported)
+	})
+
+	t.Run("should not decode examples for other media types", func(t *testing.T) {
+		_, err := decodeExample("image/png", "...", &schema, nil)
+		require.ErrorIs(t, err, errExampleMediaTypeNotSupported)
+	})
+}
+
+func TestExampleValidator_MediaTypes(t *testing.T) {
+	res, _ := loadFixtureAndValidate(t, "fixture-example-media-types.yaml")
+	require.TrueT(t, res.IsValid())
+
+	warnings := verifiedTestWarnings(res)
+	for _, msg := range []string{
+		`/pets.examples.name in body must be of type string: "number"`,
+		`/pets.examples.age in body must be of type integer: "string"`,
+		`/pets.examples.name in body is required`,
+		`No validation attempt for examples for media type image/png, in operation "getPets", response 200`,
+		`/pets.examples.status in body must be of type integer: "string"`,
+		`No validation attempt for examples for media type text/plain, in operation "getPets", default response`,
+		`in operation "getPets", default response example for media type application/xml cannot be parsed: XML syntax error on line 1: unexpected EOF`,
+		`/ping.examples in body should be at most 4 chars long`,
+	} {
+		assert.SliceContainsT(t, warnings, msg)
+	}
+	assert.Len(t, warnings, 8)
+}
+
+func TestExampleValidator_XMLCircularRef(t *testing.T) {
+	res, _ := loadJSONAndValidate(t, `{
+		"swagger": "2.0",
+		"info": {"title": "t", "version": "1"},
+		"produces": ["application/xml"],
+		"paths": {
+			"/r": {
+				"get": {
+					"operationId": "getR",
+					"responses": {
+						"200": {
+							"description": "ok",
+							"schema": {"type": "object", "properties": {"a": {"$ref": "#/definitions/A"}}},
+							"examples": {"application/xml": "<r><a>1</a></r>"}
+						}
+					}
+				}
+			}
+		},
+		"definitions": {
+			"A": {"$ref": "#/definitions/B"},
+			"B": {"$ref": "#/definitions/A"}
+		}
+	}`)
+	require.NotNil(t, res)
+
+	example, err := decodeExample("application/xml", "<r><a>1</a></r>", &spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:       spec.StringOrArray{objectType},
+		Properties: spec.SchemaProperties{"a": *spec.RefSchema("#/definitions/A")},
+	}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "1"}, example)
+}
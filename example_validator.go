@@ -4,9 +4,11 @@
 package validate
 
 import (
-	"fmt"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag/stringutils"
 )
 
 // ExampleValidator validates example values defined in a spec.
@@ -49,17 +51,6 @@ func (ex *exampleValidator) resetVisited() {
 	}
 }
 
-// beingVisited asserts a schema is being visited.
-func (ex *exampleValidator) beingVisited(path string) {
-	ex.visitedSchemas[path] = struct{}{}
-}
-
-// isVisited tells if a path has already been visited.
-func (ex *exampleValidator) isVisited(path string) bool {
-	return isVisited(path, ex.visitedSchemas)
-}
-
-//nolint:gocognit // refactor in a forthcoming PR
 func (ex *exampleValidator) validateExampleValueValidAgainstSchema() *Result {
 	// every example value that is specified must validate against the schema for that property
 	// in: schemas, properties, object, items
@@ -68,88 +59,98 @@ func (ex *exampleValidator) validateExampleValueValidAgainstSchema() *Result {
 	res := pools.poolOfResults.BorrowResult()
 	s := ex.SpecValidator
 
-	for method, pathItem := range s.expandedAnalyzer().Operations() {
-		for path, op := range pathItem {
-			// parameters
-			for _, param := range paramHelp.safeExpandedParamsFor(path, method, op.ID, res, s) {
-
-				// As of swagger 2.0, Examples are not supported in simple parameters
-				// However, it looks like it is supported by go-openapi
-
-				// reset explored schemas to get depth-first recursive-proof exploration
-				ex.resetVisited()
-
-				// Check simple parameters first
-				// default values provided must validate against their inline definition (no explicit schema)
-				if param.Example != nil && param.Schema == nil {
-					// check param default value is valid
-					red := newParamValidator(&param, s.KnownFormats, ex.schemaOptions).Validate(param.Example) //#nosec
-					if red.HasErrorsOrWarnings() {
-						res.AddWarnings(exampleValueDoesNotValidateMsg(param.Name, param.In))
-						res.MergeAsWarnings(red)
-					} else if red.wantsRedeemOnMerge {
-						pools.poolOfResults.RedeemResult(red)
-					}
-				}
-
-				// Recursively follows Items and Schemas
-				if param.Items != nil {
-					red := ex.validateExampleValueItemsAgainstSchema(param.Name, param.In, &param, param.Items) //#nosec
-					if red.HasErrorsOrWarnings() {
-						res.AddWarnings(exampleValueItemsDoesNotValidateMsg(param.Name, param.In))
-						res.Merge(red)
-					} else if red.wantsRedeemOnMerge {
-						pools.poolOfResults.RedeemResult(red)
-					}
-				}
-
-				if param.Schema != nil {
-					// Validate example value against schema
-					red := ex.validateExampleValueSchemaAgainstSchema(param.Name, param.In, param.Schema)
-					if red.HasErrorsOrWarnings() {
-						res.AddWarnings(exampleValueDoesNotValidateMsg(param.Name, param.In))
-						res.Merge(red)
-					} else if red.wantsRedeemOnMerge {
-						pools.poolOfResults.RedeemResult(red)
-					}
-				}
+	s.walkOperations(res, operationVisitor{
+		operation: func(method, path string, op *spec.Operation) {
+			// Empty op.ID means there is no meaningful operation: no need to report a specific message
+			if op.Responses == nil && op.ID != "" {
+				res.AddErrors(FindingAt(operationPointer(method, path), noValidResponseMsg(op.ID)))
 			}
+		},
+		param: func(method, path string, _ *spec.Operation, param *spec.Parameter) {
+			res.Merge(ex.validateExampleInParam(param).locate(s.paramPointer(method, path, param)))
+		},
+		response: func(method, path string, op *spec.Operation, response *spec.Response, responseType string, responseCode int) {
+			red := ex.validateExampleInResponse(response, responseType, path, responseCode, op.ID)
+			res.Merge(red.locate(responsePointer(method, path, responseType, responseCode)))
+		},
+	})
 
-			if op.Responses != nil {
-				if op.Responses.Default != nil {
-					// Same constraint on default Response
-					res.Merge(ex.validateExampleInResponse(op.Responses.Default, jsonDefault, path, 0, op.ID))
-				}
-				// Same constraint on regular Responses
-				if op.Responses.StatusCodeResponses != nil { // Safeguard
-					for code, r := range op.Responses.StatusCodeResponses {
-						res.Merge(ex.validateExampleInResponse(&r, "response", path, code, op.ID)) //#nosec
-					}
-				}
-			} else if op.ID != "" {
-				// Empty op.ID means there is no meaningful operation: no need to report a specific message
-				res.AddErrors(noValidResponseMsg(op.ID))
-			}
-		}
-	}
 	if s.spec.Spec().Definitions != nil { // Safeguard
 		// reset explored schemas to get depth-first recursive-proof exploration
 		ex.resetVisited()
 		for nm, sch := range s.spec.Spec().Definitions {
-			res.Merge(ex.validateExampleValueSchemaAgainstSchema("definitions."+nm, "body", &sch)) //#nosec
+			red := ex.validateExampleValueSchemaAgainstSchema("definitions."+nm, "body", &sch) //#nosec
+			res.Merge(red.locate(definitionPointer(nm)))
 		}
 	}
 	return res
 }
 
-func (ex *exampleValidator) validateExampleInResponse(resp *spec.Response, responseType, path string, responseCode int, operationID string) *Result {
+func (ex *exampleValidator) validateExampleInParam(param *spec.Parameter) *Result {
+	res := pools.poolOfResults.BorrowResult()
 	s := ex.SpecValidator
 
-	response, res := responseHelp.expandResponseRef(resp, path, s)
-	if !res.IsValid() { // Safeguard
-		return res
+	// As of swagger 2.0, Examples are not supported in simple parameters
+	// However, it looks like it is supported by go-openapi
+
+	// reset explored schemas to get depth-first recursive-proof exploration
+	ex.resetVisited()
+
+	// Check simple parameters first
+	// default values provided must validate against their inline definition (no explicit schema)
+	if param.Example != nil && param.Schema == nil {
+		// check param default value is valid
+		red := newParamValidator(param, s.KnownFormats, ex.schemaOptions).Validate(param.Example)
+		if red.HasErrorsOrWarnings() {
+			res.AddWarnings(exampleValueDoesNotValidateMsg(param.Name, param.In))
+			res.MergeAsWarnings(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
 	}
 
+	// x-example values on non-body parameters are provided in their serialized form
+	if example, ok := xExampleValue(param.Extensions); ok && param.Schema == nil {
+		red := ex.validateXExample(param.Name, param.In, &param.SimpleSchema, example, func(value any) *Result {
+			return newParamValidator(param, s.KnownFormats, ex.schemaOptions).Validate(value)
+		})
+		if red.HasErrorsOrWarnings() {
+			res.AddWarnings(exampleValueDoesNotValidateMsg(param.Name, param.In))
+			res.MergeAsWarnings(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	// Recursively follows Items and Schemas
+	if param.Items != nil {
+		red := ex.validateExampleValueItemsAgainstSchema(param.Name, param.In, param, param.Items)
+		if red.HasErrorsOrWarnings() {
+			res.AddWarnings(exampleValueItemsDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	if param.Schema != nil {
+		// Validate example value against schema
+		red := ex.validateExampleValueSchemaAgainstSchema(param.Name, param.In, param.Schema)
+		if red.HasErrorsOrWarnings() {
+			res.AddWarnings(exampleValueDoesNotValidateMsg(param.Name, param.In))
+			res.Merge(red)
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	}
+
+	return res
+}
+
+func (ex *exampleValidator) validateExampleInResponse(response *spec.Response, responseType, path string, responseCode int, operationID string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	s := ex.SpecValidator
+
 	responseName, responseCodeAsStr := responseHelp.responseMsgVariants(responseType, responseCode)
 
 	if response.Headers != nil { // Safeguard
@@ -167,6 +168,18 @@ func (ex *exampleValidator) validateExampleInResponse(resp *spec.Response, respo
 				}
 			}
 
+			if example, ok := xExampleValue(h.Extensions); ok {
+				red := ex.validateXExample(nm, "header", &h.SimpleSchema, example, func(value any) *Result {
+					return newHeaderValidator(nm, &h, s.KnownFormats, ex.schemaOptions).Validate(value) //#nosec
+				})
+				if red.HasErrorsOrWarnings() {
+					res.AddWarnings(exampleValueHeaderDoesNotValidateMsg(operationID, nm, responseName))
+					res.MergeAsWarnings(red)
+				} else if red.wantsRedeemOnMerge {
+					pools.poolOfResults.RedeemResult(red)
+				}
+			}
+
 			// Headers have inline definition, like params
 			if h.Items != nil {
 				red := ex.validateExampleValueItemsAgainstSchema(nm, "header", &h, h.Items) //#nosec
@@ -201,14 +214,7 @@ func (ex *exampleValidator) validateExampleInResponse(resp *spec.Response, respo
 
 	if response.Examples != nil {
 		if response.Schema != nil {
-			if example, ok := response.Examples["application/json"]; ok {
-				res.MergeAsWarnings(
-					newSchemaValidator(response.Schema, s.spec.Spec(), path+".examples", s.KnownFormats, s.schemaOptions).Validate(example),
-				)
-			} else {
-				// Proposal for enhancement: validate other media types too
-				res.AddWarnings(examplesMimeNotSupportedMsg(operationID, responseName))
-			}
+			res.Merge(ex.validateResponseExamples(path, operationID, responseName, response))
 		} else {
 			res.AddWarnings(examplesWithoutSchemaMsg(operationID, responseName))
 		}
@@ -217,51 +223,22 @@ func (ex *exampleValidator) validateExampleInResponse(resp *spec.Response, respo
 }
 
 func (ex *exampleValidator) validateExampleValueSchemaAgainstSchema(path, in string, schema *spec.Schema) *Result {
-	if schema == nil || ex.isVisited(path) {
-		// Avoids recursing if we are already done with that check
-		return nil
-	}
-	ex.beingVisited(path)
-	s := ex.SpecValidator
 	res := pools.poolOfResults.BorrowResult()
+	s := ex.SpecValidator
 
-	if schema.Example != nil {
-		res.MergeAsWarnings(
-			newSchemaValidator(schema, s.spec.Spec(), path+".example", s.KnownFormats, ex.schemaOptions).Validate(schema.Example),
-		)
-	}
-	if schema.Items != nil {
-		if schema.Items.Schema != nil {
-			res.Merge(ex.validateExampleValueSchemaAgainstSchema(path+".items.example", in, schema.Items.Schema))
+	walkSchema(path, ".example", schema, ex.visitedSchemas, func(path string, schema *spec.Schema) bool {
+		if schema.Example != nil {
+			res.MergeAsWarnings(
+				newSchemaValidator(schema, s.spec.Spec(), path+".example", s.KnownFormats, ex.schemaOptions).Validate(schema.Example),
+			)
 		}
-		// Multiple schemas in items
-		if schema.Items.Schemas != nil { // Safeguard
-			for i, sch := range schema.Items.Schemas {
-				res.Merge(ex.validateExampleValueSchemaAgainstSchema(fmt.Sprintf("%s.items[%d].example", path, i), in, &sch)) //#nosec
-			}
+		if _, err := compileRegexp(schema.Pattern); err != nil {
+			res.AddErrors(invalidPatternInMsg(path, in, schema.Pattern))
 		}
-	}
-	if _, err := compileRegexp(schema.Pattern); err != nil {
-		res.AddErrors(invalidPatternInMsg(path, in, schema.Pattern))
-	}
-	if schema.AdditionalItems != nil && schema.AdditionalItems.Schema != nil {
-		// NOTE: we keep validating values, even though additionalItems is unsupported in Swagger 2.0 (and 3.0 as well)
-		res.Merge(ex.validateExampleValueSchemaAgainstSchema(path+".additionalItems", in, schema.AdditionalItems.Schema))
-	}
-	for propName, prop := range schema.Properties {
-		res.Merge(ex.validateExampleValueSchemaAgainstSchema(path+"."+propName, in, &prop)) //#nosec
-	}
-	for propName, prop := range schema.PatternProperties {
-		res.Merge(ex.validateExampleValueSchemaAgainstSchema(path+"."+propName, in, &prop)) //#nosec
-	}
-	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
-		res.Merge(ex.validateExampleValueSchemaAgainstSchema(path+".additionalProperties", in, schema.AdditionalProperties.Schema))
-	}
-	if schema.AllOf != nil {
-		for i, aoSch := range schema.AllOf {
-			res.Merge(ex.validateExampleValueSchemaAgainstSchema(fmt.Sprintf("%s.allOf[%d]", path, i), in, &aoSch)) //#nosec
-		}
-	}
+
+		return true
+	})
+
 	return res
 }
 
@@ -277,6 +254,11 @@ func (ex *exampleValidator) validateExampleValueItemsAgainstSchema(path, in stri
 				newItemsValidator(path, in, items, root, s.KnownFormats, ex.schemaOptions).Validate(0, items.Example),
 			)
 		}
+		if example, ok := xExampleValue(items.Extensions); ok {
+			res.MergeAsWarnings(ex.validateXExample(path, in, &items.SimpleSchema, example, func(value any) *Result {
+				return newItemsValidator(path, in, items, root, s.KnownFormats, ex.schemaOptions).Validate(0, value)
+			}))
+		}
 		if items.Items != nil {
 			res.Merge(ex.validateExampleValueItemsAgainstSchema(path+"[0].example", in, root, items.Items))
 		}
@@ -287,3 +269,88 @@ func (ex *exampleValidator) validateExampleValueItemsAgainstSchema(path, in stri
 
 	return res
 }
+
+// validateXExample validates a x-example value, after converting it from its serialized form.
+func (ex *exampleValidator) validateXExample(name, in string, schema *spec.SimpleSchema, example any, validate func(any) *Result) *Result {
+	value, err := exampleFromSerialized(name, in, schema, example)
+	if err != nil {
+		res := pools.poolOfResults.BorrowResult()
+		res.AddErrors(err)
+
+		return res
+	}
+
+	return validate(value)
+}
+
+// xExampleValue returns the value of the x-example extension, as used by go-swagger on non-body
+// parameters, items and headers, which do not support example in Swagger 2.0.
+func xExampleValue(extensions spec.Extensions) (any, bool) {
+	return extensionValue(extensions, xExample)
+}
+
+// exampleFromSerialized converts an example provided as a string, like it would be sent in a request
+// (e.g. "1,2,3" for a csv array of integers), to the type declared by a simple schema.
+//
+// Arrays are split according to their collectionFormat. Non-string examples are returned unchanged.
+func exampleFromSerialized(name, in string, schema *spec.SimpleSchema, example any) (any, error) {
+	str, isString := example.(string)
+	if !isString {
+		return example, nil
+	}
+
+	switch schema.Type {
+	case arrayType:
+		var parts []string
+		if schema.CollectionFormat == collectionFormatMulti {
+			// a single example stands for a single occurrence of the parameter
+			parts = []string{str}
+		} else {
+			parts = stringutils.SplitByFormat(str, schema.CollectionFormat)
+		}
+
+		values := make([]any, 0, len(parts))
+		for i, part := range parts {
+			if schema.Items == nil {
+				values = append(values, part)
+
+				continue
+			}
+
+			value, err := exampleFromSerialized(name+"."+strconv.Itoa(i), in, &schema.Items.SimpleSchema, part)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+
+		return values, nil
+
+	case integerType:
+		value, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, errors.InvalidType(name, in, integerType, str)
+		}
+
+		return value, nil
+
+	case numberType:
+		value, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, errors.InvalidType(name, in, numberType, str)
+		}
+
+		return value, nil
+
+	case booleanType:
+		value, err := strconv.ParseBool(str)
+		if err != nil {
+			return nil, errors.InvalidType(name, in, booleanType, str)
+		}
+
+		return value, nil
+
+	default:
+		return str, nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
 )
 
 func TestExample_ValidateExamples(t *testing.T) {
@@ -108,3 +109,49 @@ func TestExample_EdgeCase(t *testing.T) {
 	res = myExampleValidator.Validate()
 	assert.TrueT(t, res.IsValid())
 }
+
+func TestExample_SerializedExample(t *testing.T) {
+	csvOfIntegers := &spec.SimpleSchema{Type: arrayType, Items: &spec.Items{SimpleSchema: spec.SimpleSchema{Type: integerType}}}
+
+	value, err := exampleFromSerialized("ids", "query", csvOfIntegers, "1, 2,3")
+	require.NoError(t, err)
+	assert.Equal(t, []any{int64(1), int64(2), int64(3)}, value)
+
+	pipesOfCSV := &spec.SimpleSchema{
+		Type:             arrayType,
+		CollectionFormat: "pipes",
+		Items:            &spec.Items{SimpleSchema: spec.SimpleSchema{Type: arrayType, Items: &spec.Items{SimpleSchema: spec.SimpleSchema{Type: booleanType}}}},
+	}
+	value, err = exampleFromSerialized("flags", "query", pipesOfCSV, "true,false|false")
+	require.NoError(t, err)
+	assert.Equal(t, []any{[]any{true, false}, []any{false}}, value)
+
+	_, err = exampleFromSerialized("ids", "query", csvOfIntegers, "1,x")
+	require.EqualError(t, err, `ids.1 in query must be of type integer: "x"`)
+
+	value, err = exampleFromSerialized("limit", "query", &spec.SimpleSchema{Type: numberType}, 2.5)
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, value)
+}
+
+func TestExample_XExample(t *testing.T) {
+	res, _ := loadFixtureAndValidate(t, "fixture-x-example.yaml")
+	assert.TrueT(t, res.IsValid())
+
+	warnings := verifiedTestWarnings(res)
+	for _, msg := range []string{
+		"example value for id in path does not validate its schema",
+		"id in path should be greater than or equal to 1",
+		"example value for limit in query does not validate its schema",
+		`limit in query must be of type integer: "ten"`,
+		"example value for tags in query does not validate its schema",
+		"tags in query should have at most 2 items",
+		"example value for sizes.items in query does not validate its schema",
+		"sizes.0 in query should be less than or equal to 10",
+		`in operation "getPet", example value in header X-Rate-Limit for response 200 does not validate its schema`,
+		"X-Rate-Limit in response should be less than or equal to 100",
+	} {
+		assert.SliceContainsT(t, warnings, msg)
+	}
+	assert.Len(t, warnings, 10)
+}
@@ -10,6 +10,7 @@ require (
 	github.com/go-openapi/swag/conv v0.26.1
 	github.com/go-openapi/swag/fileutils v0.26.1
 	github.com/go-openapi/swag/jsonutils v0.26.1
+	github.com/go-openapi/swag/mangling v0.26.1
 	github.com/go-openapi/swag/stringutils v0.26.1
 	github.com/go-openapi/testify/v2 v2.6.0
 	go.yaml.in/yaml/v3 v3.0.4
@@ -19,7 +20,6 @@ require (
 	github.com/go-openapi/jsonreference v0.21.6 // indirect
 	github.com/go-openapi/swag/jsonname v0.26.1 // indirect
 	github.com/go-openapi/swag/loading v0.26.1 // indirect
-	github.com/go-openapi/swag/mangling v0.26.1 // indirect
 	github.com/go-openapi/swag/typeutils v0.26.1 // indirect
 	github.com/go-openapi/swag/yamlutils v0.26.1 // indirect
 	github.com/go-viper/mapstructure/v2 v2.5.0 // indirect
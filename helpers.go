@@ -7,7 +7,9 @@ package validate
 // This must be done while keeping CI intact with all tests and test coverage
 
 import (
+	"net/http"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -17,10 +19,21 @@ import (
 
 const (
 	swaggerBody     = "body"
+	swaggerFormData = "formData"
 	swaggerExample  = "example"
 	swaggerExamples = "examples"
+	xExample        = "x-example"
 )
 
+const (
+	collectionFormatMulti = "multi"
+)
+
+// rexPathParam extracts the params of a path segment, with surrounding {}.
+//
+// NOTE: important non-greedy modifier
+var rexPathParam = regexp.MustCompile(`{[^{}]+?}`)
+
 const (
 	objectType  = "object"
 	arrayType   = "array"
@@ -147,6 +160,43 @@ func (h *pathHelper) extractPathParams(path string) (params []string) {
 	return
 }
 
+func (h *pathHelper) isTemplatedSegment(segment string) bool {
+	// Tells if a path segment contains some path parameter
+	return strings.ContainsAny(segment, "{}")
+}
+
+func (h *pathHelper) segmentParam(segment string) (string, bool) {
+	// Returns the name of the path parameter when the segment is exactly one parameter, e.g. "{id}"
+	if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+		return "", false
+	}
+	name := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+	if name == "" || strings.ContainsAny(name, "{}") {
+		return "", false
+	}
+	return name, true
+}
+
+func (h *pathHelper) segmentMatches(segment, literal string) bool {
+	// Tells if a templated path segment, e.g. "{id}" or "{name}.json", may match a literal segment
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, loc := range rexPathParam.FindAllStringIndex(segment, -1) {
+		pattern.WriteString(regexp.QuoteMeta(segment[last:loc[0]]))
+		pattern.WriteString("[^/]+")
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(segment[last:]))
+	pattern.WriteString("$")
+
+	rex, err := compileRegexp(pattern.String())
+	if err != nil { // Safeguard: the pattern is always valid
+		return false
+	}
+	return rex.MatchString(literal)
+}
+
 type valueHelper struct {
 	// A collection of unexported helpers for value validation
 }
@@ -214,7 +264,7 @@ func (h *paramHelper) safeExpandedParamsFor(path, method, operationID string, re
 		resolvedParams := []spec.Parameter{}
 		for _, ppr := range operation.Parameters {
 			resolvedParam, red := h.resolveParam(path, method, operationID, &ppr, s) //#nosec
-			res.Merge(red)
+			res.Merge(red.locate(s.paramPointer(method, path, &ppr)))
 			if resolvedParam != nil {
 				resolvedParams = append(resolvedParams, *resolvedParam)
 			}
@@ -225,9 +275,9 @@ func (h *paramHelper) safeExpandedParamsFor(path, method, operationID string, re
 		for _, ppr := range s.expandedAnalyzer().SafeParamsFor(method, path,
 			func(_ spec.Parameter, err error) bool {
 				// since params have already been expanded, there are few causes for error
-				res.AddErrors(someParametersBrokenMsg(path, method, operationID))
+				res.AddErrors(FindingAt(operationPointer(method, path), someParametersBrokenMsg(path, method, operationID)))
 				// original error from analyzer
-				res.AddErrors(err)
+				res.AddErrors(FindingAt(operationPointer(method, path), err))
 				return true
 			}) {
 			params = append(params, ppr)
@@ -240,7 +290,6 @@ func (h *paramHelper) resolveParam(path, method, operationID string, param *spec
 	// Ensure parameter is expanded
 	var err error
 	res := new(Result)
-	isRef := param.Ref.String() != ""
 	if s.spec.SpecFilePath() == "" {
 		err = spec.ExpandParameterWithRoot(param, s.spec.Spec(), nil)
 	} else {
@@ -252,28 +301,22 @@ func (h *paramHelper) resolveParam(path, method, operationID string, param *spec
 		errorHelp.addPointerError(res, err, param.Ref.String(), refPath)
 		return nil, res
 	}
-	res.Merge(h.checkExpandedParam(param, param.Name, param.In, operationID, isRef))
+	res.Merge(h.checkExpandedParam(param, param.Name, param.In, operationID))
 	return param, res
 }
 
-func (h *paramHelper) checkExpandedParam(pr *spec.Parameter, path, in, operation string, isRef bool) *Result {
+func (h *paramHelper) checkExpandedParam(pr *spec.Parameter, path, in, operation string) *Result {
 	// Secure parameter structure after $ref resolution
 	res := new(Result)
 	simpleZero := spec.SimpleSchema{}
 	// Try to explain why... best guess
+	//
+	// NOTE: a $ref with siblings, which most likely explains why a schema took over the parameter,
+	// is reported by the ref-siblings rule.
 	switch {
 	case pr.In == swaggerBody && (pr.SimpleSchema != simpleZero && pr.Type != objectType):
-		if isRef {
-			// Most likely, a $ref with a sibling is an unwanted situation: in itself this is a warning...
-			// but we detect it because of the following error:
-			// schema took over Parameter for an unexplained reason
-			res.AddWarnings(refShouldNotHaveSiblingsMsg(path, operation))
-		}
 		res.AddErrors(invalidParameterDefinitionMsg(path, in, operation))
 	case pr.In != swaggerBody && pr.Schema != nil:
-		if isRef {
-			res.AddWarnings(refShouldNotHaveSiblingsMsg(path, operation))
-		}
 		res.AddErrors(invalidParameterDefinitionAsSchemaMsg(path, in, operation))
 	case (pr.In == swaggerBody && pr.Schema == nil) || (pr.In != swaggerBody && pr.SimpleSchema == simpleZero):
 		// Other unexpected mishaps
@@ -282,6 +325,58 @@ func (h *paramHelper) checkExpandedParam(pr *spec.Parameter, path, in, operation
 	return res
 }
 
+// reservedHeaderParams lists header parameters which conflict with other declarations of an operation,
+// with the declaration to use instead.
+var reservedHeaderParams = map[string]string{
+	"accept":        "produces",
+	"authorization": "securityDefinitions",
+	"content-type":  "consumes",
+}
+
+// checkParamLocation checks the rules which depend on the location of a parameter:
+//
+//   - allowEmptyValue is only valid for query and formData params
+//   - header params named Accept, Content-Type or Authorization conflict with produces, consumes and security
+//   - a body param on GET, HEAD or DELETE has no defined semantics
+//   - path params may not be objects, and arrays should declare their collectionFormat
+//   - path params are always required, so a default value is never used
+func (h *paramHelper) checkParamLocation(pr *spec.Parameter, method, operation string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	if pr.AllowEmptyValue && pr.In != "query" && pr.In != swaggerFormData {
+		res.AddErrors(allowEmptyValueNotAllowedMsg(operation, pr.Name, pr.In))
+	}
+
+	switch pr.In {
+	case "header":
+		if replacement, reserved := reservedHeaderParams[strings.ToLower(pr.Name)]; reserved {
+			res.AddWarnings(reservedHeaderParamMsg(operation, pr.Name, replacement))
+		}
+
+	case swaggerBody:
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodDelete:
+			res.AddWarnings(bodyParamWithoutBodySemanticsMsg(operation, pr.Name, method))
+		}
+
+	case "path":
+		switch pr.Type {
+		case objectType:
+			res.AddErrors(pathParamObjectTypeMsg(operation, pr.Name))
+		case arrayType:
+			if pr.CollectionFormat == "" {
+				res.AddWarnings(pathParamArrayWithoutCollectionFormatMsg(operation, pr.Name))
+			}
+		}
+
+		if pr.Default != nil {
+			res.AddWarnings(pathParamDefaultMsg(operation, pr.Name))
+		}
+	}
+
+	return res
+}
+
 type responseHelper struct {
 	// A collection of unexported helpers for response resolution
 }
@@ -322,3 +417,24 @@ func (r *responseHelper) responseMsgVariants(
 	}
 	return
 }
+
+// resolvedSchema follows $ref in a schema. It returns nil if the schema cannot be resolved.
+func resolvedSchema(schema *spec.Schema, root any) *spec.Schema {
+	seen := make(map[string]bool)
+	for schema != nil && schema.Ref.String() != "" {
+		ref := schema.Ref.String()
+		if seen[ref] {
+			return nil
+		}
+		seen[ref] = true
+
+		resolved, err := spec.ResolveRef(root, &schema.Ref)
+		if err != nil {
+			// unresolved references are reported elsewhere
+			return nil
+		}
+		schema = resolved
+	}
+
+	return schema
+}
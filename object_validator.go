@@ -239,57 +239,6 @@ func (o *objectValidator) validateNoAdditionalProperties(val map[string]any, res
 		}
 
 		res.AddErrors(errors.PropertyNotAllowed(o.Path, o.In, k))
-
-		// BUG(fredbi): This section should move to a part dedicated to spec validation as
-		// it will conflict with regular schemas where a property "headers" is defined.
-
-		//
-		// Croaks a more explicit message on top of the standard one
-		// on some recognized cases.
-		//
-		// NOTE: edge cases with invalid type assertion are simply ignored here.
-		// NOTE: prefix your messages here by "IMPORTANT!" so there are not filtered
-		// by higher level callers (the IMPORTANT! tag will be eventually
-		// removed).
-		if k != "headers" || val[k] == nil {
-			continue
-		}
-
-		// $ref is forbidden in header
-		headers, mapOk := val[k].(map[string]any)
-		if !mapOk {
-			continue
-		}
-
-		for headerKey, headerBody := range headers {
-			if headerBody == nil {
-				continue
-			}
-
-			headerSchema, mapOfMapOk := headerBody.(map[string]any)
-			if !mapOfMapOk {
-				continue
-			}
-
-			_, found := headerSchema["$ref"]
-			if !found {
-				continue
-			}
-
-			refString, stringOk := headerSchema["$ref"].(string)
-			if !stringOk {
-				continue
-			}
-
-			msg := strings.Join([]string{", one may not use $ref=\":", refString, "\""}, "")
-			res.AddErrors(refNotAllowedInHeaderMsg(o.Path, headerKey, msg))
-			/*
-				case "$ref":
-					if val[k] != nil {
-						// Proposal for enhancement: check context of that ref: warn about siblings, check against invalid context
-					}
-			*/
-		}
 	}
 }
 
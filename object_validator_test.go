@@ -19,7 +19,6 @@ package validate
 
 import (
 	"reflect"
-	"strings"
 	"testing"
 
 	"github.com/go-openapi/spec"
@@ -206,7 +205,8 @@ func TestObjectValidatorNilData(t *testing.T) {
 }
 
 func TestObjectValidatorWithHeaderProperty(t *testing.T) {
-	t.Run("should report extra information about forbidden $ref in this context", func(t *testing.T) {
+	t.Run("should report forbidden $ref in headers as a forbidden property", func(t *testing.T) {
+		// explicit messages about $ref in headers are reported by the spec validator
 		s := newObjectValidator("test", "body", nil, nil, nil, nil, &spec.SchemaOrBool{
 			Schema: &spec.Schema{},
 			Allows: false,
@@ -220,17 +220,8 @@ func TestObjectValidatorWithHeaderProperty(t *testing.T) {
 			},
 		})
 		require.NotNil(t, res)
-		require.Len(t, res.Errors, 2)
-		found := 0
-		for _, err := range res.Errors {
-			switch {
-			case strings.Contains(err.Error(), "forbidden property"):
-				found++
-			case strings.Contains(err.Error(), "$ref are not allowed in headers"):
-				found++
-			}
-		}
-		require.EqualT(t, 2, found)
+		require.Len(t, res.Errors, 1)
+		require.ErrorContains(t, res.Errors[0], "forbidden property")
 	})
 
 	t.Run("should NOT report extra information when header is not detected", func(t *testing.T) {
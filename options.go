@@ -6,8 +6,6 @@ package validate
 import "sync"
 
 // Opts specifies validation options for a [SpecValidator].
-//
-// NOTE: other options might be needed, for example a go-swagger specific mode.
 type Opts struct {
 	ContinueOnErrors bool // true: continue reporting errors, even if spec is invalid
 
@@ -21,6 +19,72 @@ type Opts struct {
 	// /"shelve/*/book/*" respectively.
 	StrictPathParamUniqueness bool
 	SkipSchemataResult        bool
+
+	// StrictPathAmbiguity reports as errors the paths that a router would not be able
+	// to tell apart, e.g. GET:/users/me and GET:/users/{id}, GET:/users and GET:/users/,
+	// or GET:/users and GET:/Users. By default, these are reported as warnings.
+	StrictPathAmbiguity bool
+
+	// StrictRefSiblings reports as errors the keywords declared next to a $ref in a schema,
+	// a parameter or a response, e.g. a description or readOnly. These keywords are ignored,
+	// since the $ref takes over its siblings. By default, these are reported as warnings.
+	StrictRefSiblings bool
+
+	// IgnoreRefSiblingExtensions does not report vendor extensions (x-...) declared next to a $ref.
+	// Some tools, such as go-swagger with x-nullable, do honor these extensions.
+	IgnoreRefSiblingExtensions bool
+
+	// GoSwaggerMode adds checks for the constructs which break code generation with go-swagger,
+	// e.g. definitions or properties which map to the same go identifier, or invalid values
+	// for the x-go-name, x-go-type, x-omitempty and x-nullable extensions.
+	GoSwaggerMode bool
+
+	// ExtensionSchemas registers, by name, JSON schemas to validate the values of vendor extensions,
+	// e.g. x-rate-limit. See also [SpecValidator.RegisterExtension].
+	ExtensionSchemas map[string]ExtensionSchema
+
+	// StrictExtensions reports as errors the vendor extensions which are not registered in ExtensionSchemas.
+	StrictExtensions bool
+
+	// SecurityLint reports common API security smells as warnings, e.g. operations without any security
+	// requirement, API keys sent in the query string or password fields returned in responses.
+	SecurityLint bool
+
+	// UnboundedResourceLint reports as warnings the request and/or response schemas which do not bound the size
+	// of the data, e.g. arrays without maxItems or strings without maxLength. Parameters, headers and schemas
+	// declaring x-unbounded: true are exempted. By default, the lint is disabled.
+	UnboundedResourceLint UnboundedScope
+
+	// Conventions reports as warnings the names which do not follow the configured naming conventions,
+	// e.g. operationIds in camelCase or definitions in PascalCase, and the tags which are not declared
+	// or declared twice. See [DefaultConventions]. By default, conventions are not checked.
+	Conventions *Conventions
+
+	// DocumentationLint reports as warnings the operations, parameters, responses and definitions which are
+	// not documented, the missing contact or license in info, and invalid externalDocs urls. The completeness
+	// of the documentation of every operation and definition is exposed by [Result.Completeness].
+	DocumentationLint bool
+
+	// DuplicateDefinitions reports as warnings the definitions which are structurally identical,
+	// e.g. PetResponse and Pet2, and suggests a single definition to keep.
+	DuplicateDefinitions bool
+
+	// DuplicateDefinitionsIgnoreDocs ignores titles and descriptions when comparing definitions.
+	DuplicateDefinitionsIgnoreDocs bool
+
+	// Rules changes the severity of rules, globally or for some paths and definitions. It is usually
+	// loaded from a YAML or JSON file. See [LoadRulesConfig] and [RulesConfig.ApplyTo].
+	Rules *RulesConfig
+
+	// Baseline reports only the findings of spec rules which are not recorded in this baseline. See [NewBaseline].
+	Baseline *Baseline
+
+	// WarningsAsErrors promotes the warnings of spec rules to errors, e.g. to enforce stricter rules in a CI.
+	WarningsAsErrors bool
+
+	// FailureThreshold is the weakest severity of the findings which fail [Result.AsError] and [SpecWithOpts],
+	// e.g. SeverityWarning to fail on warnings as well. The zero value stands for SeverityError.
+	FailureThreshold Severity
 }
 
 var (
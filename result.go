@@ -4,9 +4,9 @@
 package validate
 
 import (
-	stderrors "errors"
+	"cmp"
 	"reflect"
-	"strings"
+	"slices"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/spec"
@@ -31,6 +31,13 @@ type Result struct {
 	Warnings   []error
 	MatchCount int
 
+	// Infos are findings which do not affect the validity of the result, e.g. findings of spec rules
+	// configured with the info severity
+	Infos []error
+
+	// Hints are suggestions, which do not affect the validity of the result
+	Hints []error
+
 	// the object data
 	data any
 
@@ -44,6 +51,12 @@ type Result struct {
 	cachedFieldSchemata map[FieldKey][]*spec.Schema
 	cachedItemSchemata  map[ItemKey][]*spec.Schema
 
+	// completeness of the documentation, by operation and definition
+	completeness map[string]float64
+
+	// weakest severity of the findings which fail AsError. The zero value stands for errors.
+	failureThreshold Severity
+
 	wantsRedeemOnMerge bool
 }
 
@@ -122,6 +135,32 @@ func (r *Result) Data() any {
 	return r.data
 }
 
+// Completeness returns the completeness of the documentation of every operation and definition, in percent.
+//
+// Keys are like "operation getUsers" (or "operation GET /users" when there is no operationId) and "definition User".
+// The completeness is only computed by the spec validator, with the DocumentationLint option.
+func (r *Result) Completeness() map[string]float64 {
+	return r.completeness
+}
+
+func (r *Result) setCompleteness(key string, percent float64) {
+	if r.completeness == nil {
+		r.completeness = make(map[string]float64)
+	}
+
+	r.completeness[key] = percent
+}
+
+// mergeAnnotations merges the completeness and the failure threshold of other into r.
+func (r *Result) mergeAnnotations(other *Result) {
+	for key, percent := range other.completeness {
+		r.setCompleteness(key, percent)
+	}
+	if r.failureThreshold == 0 {
+		r.failureThreshold = other.failureThreshold
+	}
+}
+
 // RootObjectSchemata returns the schemata which apply to the root object.
 func (r *Result) RootObjectSchemata() []*spec.Schema {
 	return r.rootObjectSchemata.Slice()
@@ -175,7 +214,10 @@ func (r *Result) MergeAsErrors(others ...*Result) *Result {
 			r.resetCaches()
 			r.AddErrors(other.Errors...)
 			r.AddErrors(other.Warnings...)
+			r.AddInfos(other.Infos...)
+			r.AddHints(other.Hints...)
 			r.MatchCount += other.MatchCount
+			r.mergeAnnotations(other)
 			if other.wantsRedeemOnMerge {
 				pools.poolOfResults.RedeemResult(other)
 			}
@@ -193,7 +235,10 @@ func (r *Result) MergeAsWarnings(others ...*Result) *Result {
 			r.resetCaches()
 			r.AddWarnings(other.Errors...)
 			r.AddWarnings(other.Warnings...)
+			r.AddInfos(other.Infos...)
+			r.AddHints(other.Hints...)
 			r.MatchCount += other.MatchCount
+			r.mergeAnnotations(other)
 			if other.wantsRedeemOnMerge {
 				pools.poolOfResults.RedeemResult(other)
 			}
@@ -212,7 +257,7 @@ func (r *Result) AddErrors(errors ...error) {
 		found := false
 		if e != nil {
 			for _, isReported := range r.Errors {
-				if e.Error() == isReported.Error() {
+				if isSameFinding(e, isReported) {
 					found = true
 					break
 				}
@@ -230,7 +275,7 @@ func (r *Result) AddWarnings(warnings ...error) {
 		found := false
 		if e != nil {
 			for _, isReported := range r.Warnings {
-				if e.Error() == isReported.Error() {
+				if isSameFinding(e, isReported) {
 					found = true
 					break
 				}
@@ -242,6 +287,51 @@ func (r *Result) AddWarnings(warnings ...error) {
 	}
 }
 
+// AddInfos adds informational findings to this validation result (if not already reported).
+func (r *Result) AddInfos(infos ...error) {
+	for _, e := range infos {
+		if e != nil && !slices.ContainsFunc(r.Infos, func(isReported error) bool { return isSameFinding(e, isReported) }) {
+			r.Infos = append(r.Infos, e)
+		}
+	}
+}
+
+// AddHints adds suggestions to this validation result (if not already reported).
+func (r *Result) AddHints(hints ...error) {
+	for _, e := range hints {
+		if e != nil && !slices.ContainsFunc(r.Hints, func(isReported error) bool { return isSameFinding(e, isReported) }) {
+			r.Hints = append(r.Hints, e)
+		}
+	}
+}
+
+// isSameFinding tells if two findings are the same: they have the same message, and are reported by the same spec rule if any.
+func isSameFinding(e, isReported error) bool {
+	return e.Error() == isReported.Error() && ruleOf(e) == ruleOf(isReported)
+}
+
+// BySeverity returns the findings of this result with some severity.
+//
+// Returns nil on a nil *Result.
+func (r *Result) BySeverity(severity Severity) []error {
+	if r == nil {
+		return nil
+	}
+
+	switch severity {
+	case SeverityError:
+		return r.Errors
+	case SeverityWarning:
+		return r.Warnings
+	case SeverityInfo:
+		return r.Infos
+	case SeverityHint:
+		return r.Hints
+	default:
+		return nil
+	}
+}
+
 // IsValid returns true when this result is valid.
 //
 // Returns true on a nil *Result.
@@ -290,12 +380,31 @@ func (r *Result) Inc() {
 
 // AsError renders this result as an error interface.
 //
+// Only errors fail the result, unless the result of a spec validation has a weaker failure threshold
+// (see the FailureThreshold option): e.g. with the warning threshold, warnings are rendered as well.
+//
 // Proposal for enhancement: reporting / pretty print with path ordered and indented.
 func (r *Result) AsError() error {
-	if r.IsValid() {
+	failures := r.failures()
+	if len(failures) == 0 {
+		return nil
+	}
+	return errors.CompositeValidationError(failures...)
+}
+
+// failures returns the findings at or above the failure threshold, from the strongest to the weakest.
+func (r *Result) failures() []error {
+	if r == nil {
 		return nil
 	}
-	return errors.CompositeValidationError(r.Errors...)
+
+	threshold := cmp.Or(r.failureThreshold, SeverityError)
+	var failures []error
+	for severity := SeverityError; severity >= max(threshold, SeverityHint); severity-- {
+		failures = append(failures, r.BySeverity(severity)...)
+	}
+
+	return failures
 }
 
 func (r *Result) resetCaches() {
@@ -393,8 +502,12 @@ func (r *Result) mergeWithoutRootSchemata(other *Result) {
 	r.resetCaches()
 	r.AddErrors(other.Errors...)
 	r.AddWarnings(other.Warnings...)
+	r.AddInfos(other.Infos...)
+	r.AddHints(other.Hints...)
 	r.MatchCount += other.MatchCount
 
+	r.mergeAnnotations(other)
+
 	if other.fieldSchemata != nil {
 		if r.fieldSchemata == nil {
 			r.fieldSchemata = make([]fieldSchemata, 0, len(other.fieldSchemata))
@@ -416,47 +529,26 @@ func (r *Result) mergeWithoutRootSchemata(other *Result) {
 	}
 }
 
-func isImportant(err error) bool {
-	return strings.HasPrefix(err.Error(), "IMPORTANT!")
-}
-
-func stripImportantTag(err error) error {
-	return stderrors.New(strings.TrimPrefix(err.Error(), "IMPORTANT!")) //nolint:err113
-}
-
+// keepRelevantErrors returns a new result with the findings of r which are kept whatever the match count
+// of a schema composition: the findings with a severity weaker than errors, which do not take part in
+// the selection of the best matching schemas. The original result remains unaffected.
+//
+// This is used to work around the "matchCount" filter which would otherwise strip our result
+// from some accurate reporting from lower level validators.
 func (r *Result) keepRelevantErrors() *Result {
-	// NOTE: this one is going to disapear...
-	// keepRelevantErrors strips a result from standard errors and keeps
-	// the ones which are supposedly more accurate.
-	//
-	// The original result remains unaffected (creates a new instance of Result).
-	// This method is used to work around the "matchCount" filter which would otherwise
-	// strip our result from some accurate error reporting from lower level validators.
-	//
-	// NOTE: this implementation with a placeholder (IMPORTANT!) is neither clean nor
-	// very efficient. On the other hand, relying on go-openapi/errors to manipulate
-	// codes would require to change a lot here. So, for the moment, let's go with
-	// placeholders.
-	strippedErrors := []error{}
-	for _, e := range r.Errors {
-		if isImportant(e) {
-			strippedErrors = append(strippedErrors, stripImportantTag(e))
-		}
-	}
-	strippedWarnings := []error{}
-	for _, e := range r.Warnings {
-		if isImportant(e) {
-			strippedWarnings = append(strippedWarnings, stripImportantTag(e))
-		}
-	}
 	var strippedResult *Result
 	if r.wantsRedeemOnMerge {
 		strippedResult = pools.poolOfResults.BorrowResult()
 	} else {
 		strippedResult = new(Result)
 	}
-	strippedResult.Errors = strippedErrors
-	strippedResult.Warnings = strippedWarnings
+
+	for severity := SeverityWarning; severity >= SeverityHint; severity-- {
+		for _, e := range r.BySeverity(severity) {
+			reportFinding(strippedResult, e, severity)
+		}
+	}
+
 	return strippedResult
 }
 
@@ -464,6 +556,8 @@ func (r *Result) cleared() *Result {
 	// clear the Result to be reusable. Keep allocated capacity.
 	r.Errors = r.Errors[:0]
 	r.Warnings = r.Warnings[:0]
+	r.Infos = r.Infos[:0]
+	r.Hints = r.Hints[:0]
 	r.MatchCount = 0
 	r.data = nil
 	r.rootObjectSchemata.one = nil
@@ -476,6 +570,8 @@ func (r *Result) cleared() *Result {
 	for k := range r.cachedItemSchemata {
 		delete(r.cachedItemSchemata, k)
 	}
+	clear(r.completeness)
+	r.failureThreshold = 0
 	r.wantsRedeemOnMerge = true // mark this result as eligible for redeem when merged into another
 
 	return r
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Finding is an error, a warning, an info or a hint reported in a [Result].
+type Finding struct {
+	// Rule is the ID of the spec rule which reported the finding. It is empty when unknown,
+	// e.g. for the findings of a schema validation.
+	Rule string `json:"rule,omitempty"`
+
+	// Location is the JSON pointer to the object of the spec the finding is about, as a URI fragment,
+	// e.g. "#/definitions/User". It is empty when unknown.
+	Location string `json:"location,omitempty"`
+
+	// Fingerprint identifies the location of the finding, so that a finding may be recognized
+	// when its message is reworded.
+	Fingerprint string `json:"fingerprint"`
+
+	Message  string   `json:"message"`
+	Severity Severity `json:"severity"`
+}
+
+// Findings returns the errors, warnings, infos and hints of a result, in this order.
+func (r *Result) Findings() []Finding {
+	if r == nil {
+		return nil
+	}
+
+	findings := make([]Finding, 0, len(r.Errors)+len(r.Warnings)+len(r.Infos)+len(r.Hints))
+	add := func(errs []error, severity Severity) {
+		for _, err := range errs {
+			findings = append(findings, r.findingOf(err, severity))
+		}
+	}
+	add(r.Errors, SeverityError)
+	add(r.Warnings, SeverityWarning)
+	add(r.Infos, SeverityInfo)
+	add(r.Hints, SeverityHint)
+
+	return findings
+}
+
+func (r *Result) findingOf(err error, severity Severity) Finding {
+	return findingOf(err, ruleOf(err), severity)
+}
+
+func findingOf(err error, rule string, severity Severity) Finding {
+	message := err.Error()
+	location := locationOf(err)
+
+	return Finding{
+		Rule: rule, Location: location, Fingerprint: fingerprintOf(rule, location, message), Message: message, Severity: severity,
+	}
+}
+
+// ruleFinding is a finding reported by a spec rule, annotated with the ID of the rule and with the location
+// of the object of the spec it is about.
+type ruleFinding struct {
+	error
+
+	rule     string
+	location string // JSON pointer to the object, as a URI fragment, e.g. "#/definitions/User", or "" when unknown
+}
+
+// Unwrap returns the finding, as reported by the rule.
+func (f *ruleFinding) Unwrap() error {
+	return f.error
+}
+
+// FindingAt locates a finding reported by a custom [SpecRule] on the object of the spec it is about,
+// with the JSON pointer to this object, e.g. "/paths/~1users/get" or "" for the root of the spec.
+//
+// Findings are suppressed by x-validate-ignore, and their severity is configured by the overrides of
+// a [RulesConfig], according to their location. Findings which are not located are neither suppressed
+// nor overridden.
+func FindingAt(pointer string, finding error) error {
+	if finding == nil {
+		return nil
+	}
+
+	if annotated, isAnnotated := finding.(*ruleFinding); isAnnotated { //nolint:errorlint // only findings annotated by this package
+		return &ruleFinding{error: annotated.error, rule: annotated.rule, location: "#" + pointer}
+	}
+
+	return &ruleFinding{error: finding, location: "#" + pointer}
+}
+
+// withRule annotates a finding with the ID of the rule which reported it.
+func withRule(finding error, id string) error {
+	if annotated, isAnnotated := finding.(*ruleFinding); isAnnotated { //nolint:errorlint // only findings annotated by this package
+		return &ruleFinding{error: annotated.error, rule: id, location: annotated.location}
+	}
+
+	return &ruleFinding{error: finding, rule: id}
+}
+
+// ruleOf returns the ID of the rule which reported a finding, or "" when unknown.
+func ruleOf(finding error) string {
+	if annotated, isAnnotated := finding.(*ruleFinding); isAnnotated { //nolint:errorlint // only findings annotated by this package
+		return annotated.rule
+	}
+
+	return ""
+}
+
+// locationOf returns the location of a finding, e.g. "#/definitions/User", or "" when unknown.
+func locationOf(finding error) string {
+	if annotated, isAnnotated := finding.(*ruleFinding); isAnnotated { //nolint:errorlint // only findings annotated by this package
+		return annotated.location
+	}
+
+	return ""
+}
+
+// locate locates the findings of r which are not located yet on the object at some JSON pointer.
+//
+// Checks locate their findings from the innermost object they are about, e.g. a parameter, to the
+// outermost one, e.g. its operation.
+func (r *Result) locate(pointer string) *Result {
+	if r == nil {
+		return nil
+	}
+
+	for _, findings := range []*[]error{&r.Errors, &r.Warnings, &r.Infos, &r.Hints} {
+		for i, finding := range *findings {
+			if locationOf(finding) == "" {
+				(*findings)[i] = FindingAt(pointer, finding)
+			}
+		}
+	}
+
+	return r
+}
+
+// fingerprintOf returns the fingerprint of a finding: a hash of its rule and of its location,
+// or of its whole message when it is not located.
+func fingerprintOf(rule, location, message string) string {
+	if location == "" {
+		location = message
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(rule))
+	hash.Write([]byte{0})
+	hash.Write([]byte(location))
+
+	const fingerprintLength = 16
+
+	return hex.EncodeToString(hash.Sum(nil))[:fingerprintLength]
+}
+
+// ResultDiff is the difference between two results, e.g. before and after a change of a spec.
+type ResultDiff struct {
+	New       []Finding // findings of the new result only
+	Fixed     []Finding // findings of the old result only
+	Unchanged []Finding // findings of both results, as reported in the new result
+}
+
+// DiffResults compares the findings of two results.
+//
+// Findings are the same when they have the same rule and message, or else the same rule and fingerprint,
+// e.g. when their message is reworded. Severities are not compared.
+func DiffResults(before, after *Result) *ResultDiff {
+	matcher := newFindingMatcher(before.Findings())
+	findings := after.Findings()
+	matched := matcher.match(findings)
+
+	diff := &ResultDiff{Fixed: matcher.unmatched()}
+	for i, finding := range findings {
+		if matched[i] {
+			diff.Unchanged = append(diff.Unchanged, finding)
+		} else {
+			diff.New = append(diff.New, finding)
+		}
+	}
+
+	return diff
+}
+
+type findingKey struct {
+	rule        string
+	fingerprint string
+	message     string // empty when matching on the location only
+}
+
+// findingMatcher pairs findings with some known findings. Every known finding is paired once.
+type findingMatcher struct {
+	known      []Finding
+	matched    []bool
+	byMessage  map[findingKey][]int
+	byLocation map[findingKey][]int
+}
+
+func newFindingMatcher(known []Finding) *findingMatcher {
+	m := &findingMatcher{
+		known:      known,
+		matched:    make([]bool, len(known)),
+		byMessage:  make(map[findingKey][]int, len(known)),
+		byLocation: make(map[findingKey][]int, len(known)),
+	}
+
+	for i, finding := range known {
+		m.byMessage[messageKey(finding)] = append(m.byMessage[messageKey(finding)], i)
+		m.byLocation[locationKey(finding)] = append(m.byLocation[locationKey(finding)], i)
+	}
+
+	return m
+}
+
+func messageKey(finding Finding) findingKey {
+	return findingKey{rule: finding.Rule, fingerprint: finding.Fingerprint, message: finding.Message}
+}
+
+func locationKey(finding Finding) findingKey {
+	return findingKey{rule: finding.Rule, fingerprint: finding.Fingerprint}
+}
+
+// match tells which findings are known: first the ones with the same message as a known finding,
+// then the ones with the same location.
+func (m *findingMatcher) match(findings []Finding) []bool {
+	matched := make([]bool, len(findings))
+	for i, finding := range findings {
+		matched[i] = m.take(m.byMessage, messageKey(finding))
+	}
+	for i, finding := range findings {
+		if !matched[i] {
+			matched[i] = m.take(m.byLocation, locationKey(finding))
+		}
+	}
+
+	return matched
+}
+
+// take pairs a finding with the first known finding with some key, which is not paired yet.
+func (m *findingMatcher) take(index map[findingKey][]int, key findingKey) bool {
+	for candidates := index[key]; len(candidates) > 0; candidates = candidates[1:] {
+		if i := candidates[0]; !m.matched[i] {
+			m.matched[i] = true
+			index[key] = candidates[1:]
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// unmatched returns the known findings which have not been paired.
+func (m *findingMatcher) unmatched() []Finding {
+	var findings []Finding
+	for i, finding := range m.known {
+		if !m.matched[i] {
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings
+}
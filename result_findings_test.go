@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestResult_Findings(t *testing.T) {
+	res := new(Result)
+	res.AddErrors(errors.New(errors.CompositeErrorCode, `"getUsers" is defined 2 times`))
+	res.AddWarnings(withRule(errors.New(errors.CompositeErrorCode, `definition "#/definitions/User" is not used anywhere`), "unused-references"))
+	res.AddInfos(errors.New(errors.CompositeErrorCode, "info has no license"))
+
+	findings := res.Findings()
+	require.Len(t, findings, 3)
+
+	assert.EqualT(t, SeverityError, findings[0].Severity)
+	assert.Empty(t, findings[0].Rule)
+	assert.EqualT(t, SeverityWarning, findings[1].Severity)
+	assert.EqualT(t, "unused-references", findings[1].Rule)
+	assert.EqualT(t, SeverityInfo, findings[2].Severity)
+	assert.Len(t, findings[2].Fingerprint, 16)
+	assert.Empty(t, findings[2].Location)
+	assert.Empty(t, (*Result)(nil).Findings())
+}
+
+func TestFingerprintOf(t *testing.T) {
+	fingerprint := fingerprintOf("unused-references", "#/definitions/User", `definition "#/definitions/User" is not used anywhere`)
+
+	assert.EqualT(t, fingerprint, fingerprintOf("unused-references", "#/definitions/User", `definition "User" is never used`))
+	assert.NotEqual(t, fingerprint, fingerprintOf("unused-references", "#/definitions/Group", `definition "#/definitions/User" is not used anywhere`))
+	assert.NotEqual(t, fingerprint, fingerprintOf("dubious-refs", "#/definitions/User", `definition "#/definitions/User" is not used anywhere`))
+	assert.NotEqual(t, fingerprintOf("documentation", "", "info has no contact"), fingerprintOf("documentation", "", "info has no license"))
+}
+
+func TestDiffResults(t *testing.T) {
+	result := func(rule string, findings ...[2]string) *Result {
+		res := new(Result)
+		for _, finding := range findings {
+			res.AddWarnings(withRule(FindingAt(finding[0], errors.New(errors.CompositeErrorCode, "%s", finding[1])), rule))
+		}
+
+		return res
+	}
+
+	before := result("documentation",
+		[2]string{"/paths/~1users~1{id}/get", `operation "getUser" has no summary`},
+		[2]string{"/paths/~1users~1{id}/get", `operation "getUser" has no description`},
+		[2]string{"/paths/~1users/get", `operation "listUsers" has no summary`},
+	)
+	after := result("documentation",
+		[2]string{"/paths/~1users~1{id}/get", `operation "getUser" lacks a summary`},
+		[2]string{"/paths/~1users~1{id}/get", `operation "getUser" has no description`},
+		[2]string{"/paths/~1users~1{id}/delete", `operation "deleteUser" has no summary`},
+	)
+
+	diff := DiffResults(before, after)
+
+	messages := func(findings []Finding) []string {
+		var messages []string
+		for _, finding := range findings {
+			messages = append(messages, finding.Message)
+		}
+
+		return messages
+	}
+	assert.Equal(t, []string{`operation "deleteUser" has no summary`}, messages(diff.New))
+	assert.Equal(t, []string{`operation "listUsers" has no summary`}, messages(diff.Fixed))
+	assert.Equal(t, []string{`operation "getUser" lacks a summary`, `operation "getUser" has no description`}, messages(diff.Unchanged))
+
+	t.Run("should report everything as new without a previous result", func(t *testing.T) {
+		diff := DiffResults(nil, after)
+		assert.Len(t, diff.New, 3)
+		assert.Empty(t, diff.Fixed)
+		assert.Empty(t, diff.Unchanged)
+	})
+}
@@ -16,11 +16,9 @@ var (
 	errAnother    = errors.New("another Error")
 	errNew        = errors.New("new Error")
 	errAdditional = errors.New("additional Error")
-	errImportant  = errors.New("IMPORTANT!Another Error")
 
-	errOneWarning       = errors.New("one Warning")
-	errNewWarning       = errors.New("new Warning")
-	errImportantWarning = errors.New("IMPORTANT!Another Warning")
+	errOneWarning = errors.New("one Warning")
+	errNewWarning = errors.New("new Warning")
 )
 
 // Test AddError() uniqueness.
@@ -139,6 +137,18 @@ func TestResult_MergeAsWarnings(t *testing.T) {
 	assert.EqualT(t, 3, r.MatchCount)
 }
 
+func TestResult_MergeCompleteness(t *testing.T) {
+	r, other := Result{}, Result{}
+	assert.Empty(t, r.Completeness())
+
+	r.setCompleteness("operation getUsers", 50)
+	other.setCompleteness("definition User", 100)
+	r.Merge(&other)
+
+	assert.Equal(t, map[string]float64{"operation getUsers": 50, "definition User": 100}, r.Completeness())
+	assert.Empty(t, r.cleared().Completeness())
+}
+
 func TestResult_IsValid(t *testing.T) {
 	r := Result{}
 
@@ -185,11 +195,50 @@ func TestResult_HasErrorsOrWarnings(t *testing.T) {
 func TestResult_keepRelevantErrors(t *testing.T) {
 	r := Result{}
 	r.AddErrors(errOne)
-	r.AddErrors(errImportant)
 	r.AddWarnings(errOneWarning)
-	r.AddWarnings(errImportantWarning)
-	assert.Len(t, r.keepRelevantErrors().Errors, 1)
-	assert.Len(t, r.keepRelevantErrors().Warnings, 1)
+	r.AddInfos(errNew)
+	r.AddHints(errAdditional)
+
+	kept := r.keepRelevantErrors()
+	assert.Empty(t, kept.Errors)
+	assert.Equal(t, []error{errOneWarning}, kept.Warnings)
+	assert.Equal(t, []error{errNew}, kept.Infos)
+	assert.Equal(t, []error{errAdditional}, kept.Hints)
+}
+
+func TestResult_BySeverity(t *testing.T) {
+	r := Result{}
+	r.AddErrors(errOne)
+	r.AddWarnings(errOneWarning)
+	r.AddInfos(errNew)
+	r.AddHints(errAdditional, errAdditional)
+
+	assert.Equal(t, []error{errOne}, r.BySeverity(SeverityError))
+	assert.Equal(t, []error{errOneWarning}, r.BySeverity(SeverityWarning))
+	assert.Equal(t, []error{errNew}, r.BySeverity(SeverityInfo))
+	assert.Equal(t, []error{errAdditional}, r.BySeverity(SeverityHint))
+	assert.Empty(t, r.BySeverity(SeverityOff))
+	assert.Empty(t, (*Result)(nil).BySeverity(SeverityError))
+
+	merged := new(Result)
+	merged.MergeAsWarnings(&r)
+	assert.Equal(t, []error{errAdditional}, merged.BySeverity(SeverityHint))
+}
+
+func TestResult_AsErrorWithFailureThreshold(t *testing.T) {
+	r := Result{failureThreshold: SeverityWarning}
+	r.AddInfos(errNew)
+	require.NoError(t, r.AsError())
+
+	r.AddWarnings(errOneWarning)
+	require.Error(t, r.AsError())
+	assert.TrueT(t, r.IsValid())
+	assert.StringContainsT(t, r.AsError().Error(), "one Warning")
+
+	r.failureThreshold = SeverityHint
+	r.AddHints(errAdditional)
+	assert.StringContainsT(t, r.AsError().Error(), "additional Error")
+	assert.StringContainsT(t, r.AsError().Error(), "new Error")
 }
 
 func TestResult_AsError(t *testing.T) {
@@ -98,7 +98,7 @@ func (s *schemaPropsValidator) Validate(data any) *Result {
 
 	// Intermediary error results
 
-	// IMPORTANT! messages from underlying validators
+	// relevant messages from underlying validators
 	var keepResultAnyOf, keepResultOneOf, keepResultAllOf *Result
 
 	if s.Options.recycleValidators {
@@ -136,7 +136,7 @@ func (s *schemaPropsValidator) Validate(data any) *Result {
 	mainResult.Inc()
 
 	// In the end we retain best failures for schema validation
-	// plus, if any, composite errors which may explain special cases (tagged as IMPORTANT!).
+	// plus, if any, the findings of composed schemas with a severity weaker than errors.
 	return mainResult.Merge(keepResultAllOf, keepResultOneOf, keepResultAnyOf)
 }
 
@@ -149,7 +149,7 @@ func (s *schemaPropsValidator) validateAnyOf(data any, mainResult, keepResultAny
 		if s.Options.recycleValidators {
 			s.anyOfValidators[i] = nil
 		}
-		// We keep inner IMPORTANT! errors no matter what MatchCount tells us
+		// We keep inner warnings, infos and hints no matter what MatchCount tells us
 		keepResultAnyOf.Merge(result.keepRelevantErrors()) // merges (and redeems) a new instance of Result
 
 		if result.IsValid() {
@@ -195,7 +195,7 @@ func (s *schemaPropsValidator) validateOneOf(data any, mainResult, keepResultOne
 			s.oneOfValidators[i] = nil
 		}
 
-		// We keep inner IMPORTANT! errors no matter what MatchCount tells us
+		// We keep inner warnings, infos and hints no matter what MatchCount tells us
 		keepResultOneOf.Merge(result.keepRelevantErrors()) // merges (and redeems) a new instance of Result
 
 		if result.IsValid() {
@@ -250,7 +250,7 @@ func (s *schemaPropsValidator) validateAllOf(data any, mainResult, keepResultAll
 		if s.Options.recycleValidators {
 			s.allOfValidators[i] = nil
 		}
-		// We keep inner IMPORTANT! errors no matter what MatchCount tells us
+		// We keep inner warnings, infos and hints no matter what MatchCount tells us
 		keepResultAllOf.Merge(result.keepRelevantErrors())
 		if result.IsValid() {
 			validated++
@@ -272,7 +272,7 @@ func (s *schemaPropsValidator) validateNot(data any, mainResult *Result) {
 	if s.Options.recycleValidators {
 		s.notValidator = nil
 	}
-	// We keep inner IMPORTANT! errors no matter what MatchCount tells us
+	// We keep inner warnings, infos and hints no matter what MatchCount tells us
 	if result.IsValid() {
 		mainResult.AddErrors(mustNotValidatechemaMsg(s.Path))
 	}
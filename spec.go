@@ -13,7 +13,6 @@ import (
 	"strings"
 
 	"github.com/go-openapi/analysis"
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/jsonpointer"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/spec"
@@ -24,23 +23,29 @@ import (
 // Spec validates an OpenAPI 2.0 specification document.
 //
 // Returns an error flattening in a single standard error, all validation messages.
+// Only errors fail the validation: see [SpecWithOpts] to set validation options such as a weaker FailureThreshold.
 //
-//   - Proposal for enhancement: $ref should not have siblings
 //   - Proposal for enhancement: make sure documentation reflects all checks and warnings
 //   - Proposal for enhancement: check on discriminators
-//   - Proposal for enhancement: explicit message on unsupported keywords (better than "forbidden property"...)
-//   - Proposal for enhancement: full list of unresolved refs
 //   - Proposal for enhancement: validate numeric constraints (issue#581): this should be handled like defaults and examples
-//   - Proposal for enhancement: option to determine if we validate for go-swagger or in a more general context
-//   - Proposal for enhancement: check on required properties to support anyOf, allOf, oneOf
 //
 // NOTE: SecurityScopes are maps: no need to check uniqueness.
 func Spec(doc *loads.Document, formats strfmt.Registry) error {
-	errs, _ /*warns*/ := NewSpecValidator(doc.Schema(), formats).Validate(doc)
-	if errs.HasErrors() {
-		return errors.CompositeValidationError(errs.Errors...)
+	return SpecWithOpts(doc, formats)
+}
+
+// SpecWithOpts validates an OpenAPI 2.0 specification document like [Spec], with the default options
+// of a [SpecValidator] modified by opts.
+//
+// Returns an error flattening in a single standard error, all the findings at or above the FailureThreshold option.
+func SpecWithOpts(doc *loads.Document, formats strfmt.Registry, opts ...func(*Opts)) error {
+	validator := NewSpecValidator(doc.Schema(), formats)
+	for _, apply := range opts {
+		apply(&validator.Options)
 	}
-	return nil
+
+	errs, _ /*warns*/ := validator.Validate(doc)
+	return errs.AsError()
 }
 
 // SpecValidator validates a swagger 2.0 spec.
@@ -52,6 +57,9 @@ type SpecValidator struct {
 	KnownFormats  strfmt.Registry
 	Options       Opts // validation options
 	schemaOptions *SchemaValidatorOptions
+	rules         []*registeredRule // built-in and custom rules, in the order they are run
+	suppressions  []*suppression    // rules suppressed by x-validate-ignore in the spec being validated
+	baseline      *findingMatcher   // findings of the Baseline option which are not reported
 }
 
 // NewSpecValidator creates a new swagger spec validator instance.
@@ -79,6 +87,7 @@ func (s *SpecValidator) Validate(data any) (*Result, *Result) {
 	s.schemaOptions.skipSchemataResult = s.Options.SkipSchemataResult
 	var sd *loads.Document
 	errs, warnings := new(Result), new(Result)
+	errs.failureThreshold = s.Options.FailureThreshold
 
 	if v, ok := data.(*loads.Document); ok {
 		sd = v
@@ -89,6 +98,7 @@ func (s *SpecValidator) Validate(data any) (*Result, *Result) {
 	}
 	s.spec = sd
 	s.analyzer = analysis.New(sd.Spec())
+	s.expanded = nil
 
 	// Raw spec unmarshalling errors
 	var obj any
@@ -99,55 +109,48 @@ func (s *SpecValidator) Validate(data any) (*Result, *Result) {
 	}
 
 	defer func() {
-		// errs holds all errors and warnings,
-		// warnings only warnings
+		// errs holds all findings,
+		// warnings only the findings which are not errors: warnings, infos and hints
 		errs.MergeAsWarnings(warnings)
 		warnings.AddErrors(errs.Warnings...)
-	}()
+		warnings.AddInfos(errs.Infos...)
+		warnings.AddHints(errs.Hints...)
 
-	// Swagger schema validator
-	schv := newSchemaValidator(s.schema, nil, "", s.KnownFormats, s.schemaOptions)
-	errs.Merge(schv.Validate(obj)) // error -
-	// There may be a point in continuing to try and determine more accurate errors
-	if !s.Options.ContinueOnErrors && errs.HasErrors() {
-		return errs, warnings // no point in continuing
-	}
-
-	errs.Merge(s.validateReferencesValid()) // error -
-	// There may be a point in continuing to try and determine more accurate errors
-	if !s.Options.ContinueOnErrors && errs.HasErrors() {
-		return errs, warnings // no point in continuing
-	}
+		// the completeness of the documentation is reported along with the warnings
+		for key, percent := range errs.Completeness() {
+			warnings.setCompleteness(key, percent)
+		}
+	}()
 
-	errs.Merge(s.validateDuplicateOperationIDs())
-	errs.Merge(s.validateDuplicatePropertyNames()) // error -
-	errs.Merge(s.validateParameters())             // error -
-	errs.Merge(s.validateItems())                  // error -
+	s.ensureRules()
+	settings := s.ruleSettings()
+	s.suppressions = s.suppressionsOf(obj, errs)
+	s.baseline = nil
+	if s.Options.Baseline != nil {
+		s.baseline = newFindingMatcher(s.Options.Baseline.Findings)
+	}
+	run := make(map[string]bool, len(s.rules))
+	phase := PhaseSchema
+	for _, registered := range s.rules {
+		if !registered.enabled || settings.isOff(registered.rule.ID()) {
+			continue
+		}
 
-	// Properties in required definition MUST validate their schema
-	// Properties SHOULD NOT be declared as both required and readOnly (warning)
-	errs.Merge(s.validateRequiredDefinitions()) // error and warning
+		if registered.phase > phase {
+			// There may be a point in continuing to try and determine more accurate errors
+			if !s.Options.ContinueOnErrors && errs.HasErrors() {
+				return errs, warnings // no point in continuing
+			}
+			phase = registered.phase
+		}
 
-	// There may be a point in continuing to try and determine more accurate errors
-	if !s.Options.ContinueOnErrors && errs.HasErrors() {
-		return errs, warnings // no point in continuing
+		ctx := &RuleContext{Document: sd, Analyzer: s.analyzer, Expanded: s.expanded, Data: obj}
+		run[registered.rule.ID()] = s.runRule(registered.rule, ctx, settings, errs)
 	}
 
-	// Values provided as default MUST validate their schema
-	df := &defaultValidator{SpecValidator: s, schemaOptions: s.schemaOptions}
-	errs.Merge(df.Validate())
-
-	// Values provided as examples MUST validate their schema
-	// Value provided as examples in a response without schema generate a warning
-	// Known limitations: examples in responses for mime type not application/json are ignored (warning)
-	ex := &exampleValidator{SpecValidator: s, schemaOptions: s.schemaOptions}
-	errs.Merge(ex.Validate())
-
-	errs.Merge(s.validateNonEmptyPathParamNames())
-
-	// errs.Merge(s.validateRefNoSibling()) // warning only
-	errs.Merge(s.validateReferenced())  // warning only
-	errs.Merge(s.validateDubiousRefs()) // warning only
+	stale := s.validateSuppressions(run)
+	s.dropBaselined("", stale)
+	errs.MergeAsWarnings(stale)
 
 	return errs, warnings
 }
@@ -161,21 +164,21 @@ func (s *SpecValidator) validateNonEmptyPathParamNames() *Result {
 	res := pools.poolOfResults.BorrowResult()
 	if s.spec.Spec().Paths == nil {
 		// There is no Paths object: error
-		res.AddErrors(noValidPathMsg())
+		res.AddErrors(FindingAt("", noValidPathMsg()))
 
 		return res
 	}
 
 	if s.spec.Spec().Paths.Paths == nil {
 		// Paths may be empty: warning
-		res.AddWarnings(noValidPathMsg())
+		res.AddWarnings(FindingAt("/paths", noValidPathMsg()))
 
 		return res
 	}
 
 	for k := range s.spec.Spec().Paths.Paths {
 		if strings.Contains(k, "{}") {
-			res.AddErrors(emptyPathParameterMsg(k))
+			res.AddErrors(FindingAt(pathPointer(k), emptyPathParameterMsg(k)))
 		}
 	}
 
@@ -199,9 +202,20 @@ func (s *SpecValidator) validateDuplicateOperationIDs() *Result {
 			known[v]++
 		}
 	}
+
+	// duplicates are located at their first operation, in the order of the JSON pointers
+	first := make(map[string]string, len(known))
+	for method, pi := range analyzer.Operations() {
+		for path, op := range pi {
+			if pointer := operationPointer(method, path); known[op.ID] > 1 && (first[op.ID] == "" || pointer < first[op.ID]) {
+				first[op.ID] = pointer
+			}
+		}
+	}
+
 	for k, v := range known {
 		if v > 1 {
-			res.AddErrors(nonUniqueOperationIDMsg(k, v))
+			res.AddErrors(FindingAt(first[k], nonUniqueOperationIDMsg(k, v)))
 		}
 	}
 	return res
@@ -226,24 +240,24 @@ func (s *SpecValidator) validateDuplicatePropertyNames() *Result {
 
 		ancs, rec := s.validateCircularAncestry(k, sch, knownanc)
 		if rec != nil && (rec.HasErrors() || !rec.HasWarnings()) {
-			res.Merge(rec)
+			res.Merge(rec.locate(definitionPointer(k)))
 		}
 		if len(ancs) > 0 {
-			res.AddErrors(circularAncestryDefinitionMsg(k, ancs))
+			res.AddErrors(FindingAt(definitionPointer(k), circularAncestryDefinitionMsg(k, ancs)))
 			return res
 		}
 
 		knowns := make(map[string]struct{})
 		dups, rep := s.validateSchemaPropertyNames(k, sch, knowns)
 		if rep != nil && (rep.HasErrors() || rep.HasWarnings()) {
-			res.Merge(rep)
+			res.Merge(rep.locate(definitionPointer(k)))
 		}
 		if len(dups) > 0 {
 			var pns []string
 			for _, v := range dups {
 				pns = append(pns, v.Definition+"."+v.Name)
 			}
-			res.AddErrors(duplicatePropertiesMsg(k, pns))
+			res.AddErrors(FindingAt(definitionPointer(k), duplicatePropertiesMsg(k, pns)))
 		}
 
 	}
@@ -358,7 +372,7 @@ func (s *SpecValidator) validateItems() *Result {
 			for _, param := range paramHelp.safeExpandedParamsFor(path, method, op.ID, res, s) {
 
 				if param.TypeName() == arrayType && param.ItemsTypeName() == "" {
-					res.AddErrors(arrayInParamRequiresItemsMsg(param.Name, op.ID))
+					res.AddErrors(FindingAt(s.paramPointer(method, path, &param), arrayInParamRequiresItemsMsg(param.Name, op.ID)))
 					continue
 				}
 				if param.In != swaggerBody {
@@ -366,7 +380,7 @@ func (s *SpecValidator) validateItems() *Result {
 						items := param.Items
 						for items.TypeName() == arrayType {
 							if items.ItemsTypeName() == "" {
-								res.AddErrors(arrayInParamRequiresItemsMsg(param.Name, op.ID))
+								res.AddErrors(FindingAt(s.paramPointer(method, path, &param), arrayInParamRequiresItemsMsg(param.Name, op.ID)))
 								break
 							}
 							items = items.Items
@@ -375,36 +389,41 @@ func (s *SpecValidator) validateItems() *Result {
 				} else {
 					// In: body
 					if param.Schema != nil {
-						res.Merge(s.validateSchemaItems(*param.Schema, fmt.Sprintf("body param %q", param.Name), op.ID))
+						red := s.validateSchemaItems(*param.Schema, fmt.Sprintf("body param %q", param.Name), op.ID)
+						res.Merge(red.locate(s.paramPointer(method, path, &param)))
 					}
 				}
 			}
 
-			var responses []spec.Response
 			if op.Responses != nil {
 				if op.Responses.Default != nil {
-					responses = append(responses, *op.Responses.Default)
+					red := s.validateResponseItems(op.Responses.Default, op.ID)
+					res.Merge(red.locate(responsePointer(method, path, jsonDefault, 0)))
 				}
-				if op.Responses.StatusCodeResponses != nil {
-					for _, v := range op.Responses.StatusCodeResponses {
-						responses = append(responses, v)
-					}
+				for code, response := range op.Responses.StatusCodeResponses {
+					red := s.validateResponseItems(&response, op.ID)
+					res.Merge(red.locate(responsePointer(method, path, "response", code)))
 				}
 			}
+		}
+	}
+	return res
+}
 
-			for _, resp := range responses {
-				// Response headers with array
-				for hn, hv := range resp.Headers {
-					if hv.TypeName() == arrayType && hv.ItemsTypeName() == "" {
-						res.AddErrors(arrayInHeaderRequiresItemsMsg(hn, op.ID))
-					}
-				}
-				if resp.Schema != nil {
-					res.Merge(s.validateSchemaItems(*resp.Schema, "response body", op.ID))
-				}
-			}
+// Verifies constraints on array type in the headers and the schema of a response.
+func (s *SpecValidator) validateResponseItems(response *spec.Response, opID string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	// Response headers with array
+	for hn, hv := range response.Headers {
+		if hv.TypeName() == arrayType && hv.ItemsTypeName() == "" {
+			res.AddErrors(arrayInHeaderRequiresItemsMsg(hn, opID))
 		}
 	}
+	if response.Schema != nil {
+		res.Merge(s.validateSchemaItems(*response.Schema, "response body", opID))
+	}
+
 	return res
 }
 
@@ -489,7 +508,7 @@ func (s *SpecValidator) validateReferencedParameters() *Result {
 	}
 	result := pools.poolOfResults.BorrowResult()
 	for k := range expected {
-		result.AddWarnings(unusedParamMsg(k))
+		result.AddWarnings(FindingAt(strings.TrimPrefix(k, "#"), unusedParamMsg(k)))
 	}
 	return result
 }
@@ -514,7 +533,7 @@ func (s *SpecValidator) validateReferencedResponses() *Result {
 	}
 	result := pools.poolOfResults.BorrowResult()
 	for k := range expected {
-		result.AddWarnings(unusedResponseMsg(k))
+		result.AddWarnings(FindingAt(strings.TrimPrefix(k, "#"), unusedResponseMsg(k)))
 	}
 	return result
 }
@@ -540,7 +559,7 @@ func (s *SpecValidator) validateReferencedDefinitions() *Result {
 
 	result := new(Result)
 	for k := range expected {
-		result.AddWarnings(unusedDefinitionMsg(k))
+		result.AddWarnings(FindingAt(strings.TrimPrefix(k, "#"), unusedDefinitionMsg(k)))
 	}
 	return result
 }
@@ -551,14 +570,15 @@ func (s *SpecValidator) validateRequiredDefinitions() *Result {
 
 DEFINITIONS:
 	for d, schema := range s.spec.Spec().Definitions {
-		if schema.Required != nil { // Safeguard
-			for _, pn := range schema.Required {
+		// required properties declared in inline allOf, anyOf or oneOf schemas may be defined anywhere in the composition
+		if required := requiredInComposition(&schema); len(required) > 0 { //#nosec
+			for _, pn := range required {
 				red := s.validateRequiredProperties(pn, d, &schema) //#nosec
 				// NOTE: capture validity before merging: Merge may redeem `red` to the
 				// pool (wantsRedeemOnMerge), after which reading it races with a concurrent
 				// BorrowResult().cleared() in another goroutine sharing the global pool.
 				isValid := red.IsValid()
-				res.Merge(red)
+				res.Merge(red.locate(definitionPointer(d)))
 				if !isValid && !s.Options.ContinueOnErrors {
 					break DEFINITIONS // there is an error, let's stop that bleeding
 				}
@@ -617,7 +637,16 @@ func (s *SpecValidator) validateRequiredProperties(path, in string, v *spec.Sche
 	}
 
 	if !propertyMatch && !patternMatch && !additionalPropertiesMatch {
-		res.AddErrors(requiredButNotDefinedMsg(path, in))
+		if !hasComposition(v) {
+			res.AddErrors(requiredButNotDefinedMsg(path, in))
+		} else {
+			// the property may be defined by any schema in allOf, anyOf or oneOf, e.g. an inherited definition
+			compositionMatch, compositionReadOnly := s.compositionDefinesProperty(path, v, make(map[string]struct{}))
+			if !compositionMatch {
+				res.AddErrors(requiredNotDefinedInCompositionMsg(path, in))
+			}
+			isReadOnly = compositionReadOnly
+		}
 	}
 
 	if isReadOnly {
@@ -626,6 +655,85 @@ func (s *SpecValidator) validateRequiredProperties(path, in string, v *spec.Sche
 	return res
 }
 
+// compositionDefinesProperty tells if some schema in the allOf, anyOf or oneOf of a schema defines a property,
+// either explicitly or by matching patternProperties or additionalProperties.
+//
+// References are resolved against the spec and its base path. Unresolved references are reported elsewhere:
+// they are assumed to define the property.
+func (s *SpecValidator) compositionDefinesProperty(name string, v *spec.Schema, visited map[string]struct{}) (defined, isReadOnly bool) {
+	branches := make([]spec.Schema, 0, len(v.AllOf)+len(v.AnyOf)+len(v.OneOf))
+	branches = append(branches, v.AllOf...)
+	branches = append(branches, v.AnyOf...)
+	branches = append(branches, v.OneOf...)
+
+	for i := range branches {
+		branch := &branches[i]
+		if ref := branch.Ref.String(); ref != "" {
+			if _, done := visited[ref]; done {
+				continue
+			}
+			visited[ref] = struct{}{}
+
+			resolved, err := s.resolveRef(&branch.Ref)
+			if err != nil {
+				return true, false
+			}
+			branch = resolved
+		}
+
+		if prop, ok := branch.Properties[name]; ok {
+			return true, prop.ReadOnly
+		}
+
+		for pp, pv := range branch.PatternProperties {
+			if re, err := compileRegexp(pp); err == nil && re.MatchString(name) {
+				return true, pv.ReadOnly
+			}
+		}
+
+		if branch.AdditionalProperties != nil && branch.AdditionalProperties.Allows {
+			if branch.AdditionalProperties.Schema == nil {
+				return true, false
+			}
+
+			return true, branch.AdditionalProperties.Schema.ReadOnly
+		}
+
+		if defined, isReadOnly = s.compositionDefinesProperty(name, branch, visited); defined {
+			return defined, isReadOnly
+		}
+	}
+
+	return false, false
+}
+
+// hasComposition tells if a schema uses allOf, anyOf or oneOf.
+func hasComposition(v *spec.Schema) bool {
+	return len(v.AllOf) > 0 || len(v.AnyOf) > 0 || len(v.OneOf) > 0
+}
+
+// requiredInComposition collects the required properties of a schema and of its inline allOf, anyOf and oneOf schemas.
+//
+// Referenced schemas are not explored: their required properties are checked with their own definition.
+func requiredInComposition(v *spec.Schema) []string {
+	required := slices.Clone(v.Required)
+	for _, branches := range [][]spec.Schema{v.AllOf, v.AnyOf, v.OneOf} {
+		for i := range branches {
+			if branches[i].Ref.String() != "" {
+				continue
+			}
+
+			for _, pn := range requiredInComposition(&branches[i]) {
+				if !slices.Contains(required, pn) {
+					required = append(required, pn)
+				}
+			}
+		}
+	}
+
+	return required
+}
+
 //nolint:gocognit // refactor in a forthcoming PR
 func (s *SpecValidator) validateParameters() *Result {
 	// - for each method, path is unique, regardless of path parameters
@@ -637,8 +745,10 @@ func (s *SpecValidator) validateParameters() *Result {
 	// - parameters with pattern property must specify valid patterns
 	// - $ref in parameters must resolve
 	// - path param must be required
+	// - rules depending on the location of parameters (e.g. allowEmptyValue, reserved headers, body on GET)
 	res := pools.poolOfResults.BorrowResult()
 	rexGarbledPathSegment := mustCompileRegexp(`.*[{}\s]+.*`)
+	explainsKeywords := s.explainsKeywords()
 	for method, pi := range s.expandedAnalyzer().Operations() {
 		methodPaths := make(map[string]map[string]string)
 		for path, op := range pi {
@@ -647,16 +757,16 @@ func (s *SpecValidator) validateParameters() *Result {
 
 				// Warn on garbled path afer param stripping
 				if rexGarbledPathSegment.MatchString(pathToAdd) {
-					res.AddWarnings(pathStrippedParamGarbledMsg(pathToAdd))
+					res.AddWarnings(FindingAt(pathPointer(path), pathStrippedParamGarbledMsg(pathToAdd)))
 				}
 
 				// Check uniqueness of stripped paths
 				if _, found := methodPaths[method][pathToAdd]; found {
 					// Sort names for stable, testable output
 					if strings.Compare(path, methodPaths[method][pathToAdd]) < 0 {
-						res.AddErrors(pathOverlapMsg(path, methodPaths[method][pathToAdd]))
+						res.AddErrors(FindingAt(pathPointer(path), pathOverlapMsg(path, methodPaths[method][pathToAdd])))
 					} else {
-						res.AddErrors(pathOverlapMsg(methodPaths[method][pathToAdd], path))
+						res.AddErrors(FindingAt(pathPointer(methodPaths[method][pathToAdd]), pathOverlapMsg(methodPaths[method][pathToAdd], path)))
 					}
 				} else {
 					if _, found := methodPaths[method]; !found {
@@ -673,7 +783,7 @@ func (s *SpecValidator) validateParameters() *Result {
 
 			// Check parameters names uniqueness for operation
 			// NOTE: should be done after param expansion
-			res.Merge(s.checkUniqueParams(path, method, op))
+			res.Merge(s.checkUniqueParams(path, method, op).locate(operationPointer(method, path)))
 
 			// pick the root schema from the swagger specification which describes a parameter
 			origSchema, ok := s.schema.Definitions["parameter"]
@@ -688,19 +798,24 @@ func (s *SpecValidator) validateParameters() *Result {
 
 			for _, pr := range paramHelp.safeExpandedParamsFor(path, method, op.ID, res, s) {
 				// An expanded parameter must validate the Parameter schema (an unexpanded $ref always passes high-level schema validation)
-				schv := newSchemaValidator(&paramSchema, s.schema, fmt.Sprintf("%s.%s.parameters.%s", path, method, pr.Name), s.KnownFormats, s.schemaOptions)
+				paramPath := fmt.Sprintf("%s.%s.parameters.%s", path, method, pr.Name)
+				schv := newSchemaValidator(&paramSchema, s.schema, paramPath, s.KnownFormats, s.schemaOptions)
 				var obj any
 				if err := jsonutils.FromDynamicJSON(pr, &obj); err != nil {
-					res.AddErrors(err)
+					res.AddErrors(FindingAt(s.paramPointer(method, path, &pr), err))
 
 					return res
 				}
 
-				res.Merge(schv.Validate(obj))
+				red := pools.poolOfResults.BorrowResult()
+				red.Merge(schv.Validate(obj))
+				if explainsKeywords {
+					dropExplainedKeywords(red, explainedKeywords(obj, specKindParameter, paramPath))
+				}
 
 				// Validate pattern regexp for parameters with a Pattern property
 				if _, err := compileRegexp(pr.Pattern); err != nil {
-					res.AddErrors(invalidPatternInParamMsg(op.ID, pr.Name, pr.Pattern))
+					red.AddErrors(invalidPatternInParamMsg(op.ID, pr.Name, pr.Pattern))
 				}
 
 				// There must be at most one parameter in body: list them all
@@ -713,42 +828,47 @@ func (s *SpecValidator) validateParameters() *Result {
 					paramNames = append(paramNames, pr.Name)
 					// Path declared in path must have the required: true property
 					if !pr.Required {
-						res.AddErrors(pathParamRequiredMsg(op.ID, pr.Name))
+						red.AddErrors(pathParamRequiredMsg(op.ID, pr.Name))
 					}
 				}
 
-				if pr.In == "formData" {
+				if pr.In == swaggerFormData {
 					hasForm = true
 				}
 
+				// Rules depending on the location of the parameter
+				red.Merge(paramHelp.checkParamLocation(&pr, method, op.ID))
+
 				if pr.Type != numberType && pr.Type != integerType &&
 					(pr.Maximum != nil || pr.Minimum != nil || pr.MultipleOf != nil) {
 					// A non-numeric parameter has validation keywords for numeric instances (number and integer)
-					res.AddWarnings(parameterValidationTypeMismatchMsg(pr.Name, path, pr.Type))
+					red.AddWarnings(parameterValidationTypeMismatchMsg(pr.Name, path, pr.Type))
 				}
 
 				if pr.Type != stringType &&
 					// A non-string parameter has validation keywords for strings
 					(pr.MaxLength != nil || pr.MinLength != nil || pr.Pattern != "") {
-					res.AddWarnings(parameterValidationTypeMismatchMsg(pr.Name, path, pr.Type))
+					red.AddWarnings(parameterValidationTypeMismatchMsg(pr.Name, path, pr.Type))
 				}
 
 				if pr.Type != arrayType &&
 					// A non-array parameter has validation keywords for arrays
 					(pr.MaxItems != nil || pr.MinItems != nil || pr.UniqueItems) {
-					res.AddWarnings(parameterValidationTypeMismatchMsg(pr.Name, path, pr.Type))
+					red.AddWarnings(parameterValidationTypeMismatchMsg(pr.Name, path, pr.Type))
 				}
+
+				res.Merge(red.locate(s.paramPointer(method, path, &pr)))
 			}
 
 			// In:formData and In:body are mutually exclusive
 			if hasBody && hasForm {
-				res.AddErrors(bothFormDataAndBodyMsg(op.ID))
+				res.AddErrors(FindingAt(operationPointer(method, path), bothFormDataAndBodyMsg(op.ID)))
 			}
 			// There must be at most one body param
 			// Accurately report situations when more than 1 body param is declared (possibly unnamed)
 			if len(bodyParams) > 1 {
 				sort.Strings(bodyParams)
-				res.AddErrors(multipleBodyParamMsg(op.ID, bodyParams))
+				res.AddErrors(FindingAt(operationPointer(method, path), multipleBodyParamMsg(op.ID, bodyParams)))
 			}
 
 			// Check uniqueness of parameters in path
@@ -756,7 +876,7 @@ func (s *SpecValidator) validateParameters() *Result {
 			for i, p := range paramsInPath {
 				for j, q := range paramsInPath {
 					if p == q && i > j {
-						res.AddErrors(pathParamNotUniqueMsg(path, p, q))
+						res.AddErrors(FindingAt(pathPointer(path), pathParamNotUniqueMsg(path, p, q)))
 						break
 					}
 				}
@@ -766,12 +886,12 @@ func (s *SpecValidator) validateParameters() *Result {
 			rexGarbledParam := mustCompileRegexp(`{.*[{}\s]+.*}`)
 			for _, p := range paramsInPath {
 				if rexGarbledParam.MatchString(p) {
-					res.AddWarnings(pathParamGarbledMsg(path, p))
+					res.AddWarnings(FindingAt(pathPointer(path), pathParamGarbledMsg(path, p)))
 				}
 			}
 
 			// Match params from path vs params from params section
-			res.Merge(s.validatePathParamPresence(path, paramsInPath, paramNames))
+			res.Merge(s.validatePathParamPresence(path, paramsInPath, paramNames).locate(operationPointer(method, path)))
 		}
 	}
 	return res
@@ -782,7 +902,7 @@ func (s *SpecValidator) validateReferencesValid() *Result {
 	res := pools.poolOfResults.BorrowResult()
 	for _, r := range s.analyzer.AllRefs() {
 		if !r.IsValidURI(s.spec.SpecFilePath()) { // Safeguard - spec should always yield a valid URI
-			res.AddErrors(invalidRefMsg(r.String()))
+			res.AddErrors(FindingAt("", invalidRefMsg(r.String())))
 		}
 	}
 	if !res.HasErrors() {
@@ -791,10 +911,14 @@ func (s *SpecValidator) validateReferencesValid() *Result {
 		// on errors fails to report errors at all.
 		exp, err := s.spec.Expanded()
 		if err != nil {
-			res.AddErrors(unresolvedReferencesMsg(err))
+			res.AddErrors(FindingAt("", unresolvedReferencesMsg(err)))
 		}
 		s.expanded = exp
 	}
+
+	// every broken $ref is reported, not only the first one found when expanding the spec
+	res.Merge(s.validateReferencesResolution())
+
 	return res
 }
 
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+)
+
+type baselineError string
+
+func (e baselineError) Error() string {
+	return string(e)
+}
+
+// ErrBaseline indicates a baseline file which cannot be read or written.
+const ErrBaseline baselineError = "invalid baseline"
+
+// Baseline records the findings of a spec at some point, so that later validations only report new findings,
+// e.g. to adopt stricter rules on a legacy spec gradually. See the Baseline option.
+//
+// Findings are recognized by their rule and message, or else by their rule and fingerprint, i.e. their location,
+// when their message is reworded.
+type Baseline struct {
+	Findings []Finding `json:"findings"`
+}
+
+// NewBaseline records the findings of a result, sorted by rule, fingerprint and message.
+func NewBaseline(res *Result) *Baseline {
+	findings := res.Findings()
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		return cmp.Or(cmp.Compare(a.Rule, b.Rule), cmp.Compare(a.Fingerprint, b.Fingerprint), cmp.Compare(a.Message, b.Message))
+	})
+
+	return &Baseline{Findings: findings}
+}
+
+// ParseBaseline parses a JSON baseline.
+func ParseBaseline(data []byte) (*Baseline, error) {
+	var baseline Baseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBaseline, err)
+	}
+
+	return &baseline, nil
+}
+
+// LoadBaseline loads a JSON baseline file.
+func LoadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBaseline, err)
+	}
+
+	return ParseBaseline(data)
+}
+
+// WriteFile writes the baseline to a JSON file.
+func (b *Baseline) WriteFile(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrBaseline, err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("%w: %w", ErrBaseline, err)
+	}
+
+	return nil
+}
+
+// dropBaselined removes from the findings of a rule the ones recorded in the Baseline option.
+func (s *SpecValidator) dropBaselined(id string, red *Result) {
+	if s.baseline == nil {
+		return
+	}
+
+	all := slices.Concat(red.Errors, red.Warnings, red.Infos, red.Hints)
+	findings := make([]Finding, 0, len(all))
+	for _, err := range all {
+		findings = append(findings, findingOf(err, id, 0))
+	}
+	known := s.baseline.match(findings)
+
+	keep := func(errs []error, known []bool) []error {
+		kept := errs[:0]
+		for i, err := range errs {
+			if !known[i] {
+				kept = append(kept, err)
+			}
+		}
+
+		return kept
+	}
+	for _, findings := range []*[]error{&red.Errors, &red.Warnings, &red.Infos, &red.Hints} {
+		count := len(*findings)
+		*findings = keep(*findings, known[:count])
+		known = known[count:]
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_Baseline(t *testing.T) {
+	validate := func(t *testing.T, fixture string, baseline *Baseline) *Result {
+		t.Helper()
+
+		document, err := loads.Spec(filepath.Join("fixtures", "validation", fixture))
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		validator.Options.SecurityLint = true
+		validator.Options.Baseline = baseline
+		res, _ := validator.Validate(document)
+
+		return res
+	}
+
+	res := validate(t, "fixture-baseline.yaml", nil)
+	require.Len(t, res.Warnings, 3)
+
+	baseline := NewBaseline(res)
+	require.Len(t, baseline.Findings, 3)
+	assert.EqualT(t, "security-lint", baseline.Findings[0].Rule)
+	assert.EqualT(t, "unused-references", baseline.Findings[1].Rule)
+	assert.EqualT(t, SeverityWarning, baseline.Findings[1].Severity)
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	require.NoError(t, baseline.WriteFile(path))
+	loaded, err := LoadBaseline(path)
+	require.NoError(t, err)
+	assert.Equal(t, baseline, loaded)
+
+	t.Run("should not report the findings of the baseline", func(t *testing.T) {
+		res := validate(t, "fixture-baseline.yaml", loaded)
+		assert.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should report new findings only", func(t *testing.T) {
+		warnings := verifiedTestWarnings(validate(t, "fixture-baseline-changed.yaml", loaded))
+		assert.Equal(t, []string{
+			`definition "#/definitions/Role" is not used anywhere`,
+			`operation "listMembers" has no security requirement`,
+		}, warnings)
+	})
+
+	t.Run("should recognize reworded findings at the same location", func(t *testing.T) {
+		res := validate(t, "fixture-baseline-reworded.yaml", loaded)
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should reject invalid baselines", func(t *testing.T) {
+		_, err := ParseBaseline([]byte(`{"findings": "none"}`))
+		require.ErrorIs(t, err, ErrBaseline)
+
+		_, err = LoadBaseline(filepath.Join(t.TempDir(), "missing.json"))
+		require.ErrorIs(t, err, ErrBaseline)
+	})
+}
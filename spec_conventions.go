@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+)
+
+// NamingStyle is a naming convention for the names declared in a spec.
+type NamingStyle string
+
+// Naming conventions. The zero value does not check names.
+const (
+	CamelCase  NamingStyle = "camelCase"
+	PascalCase NamingStyle = "PascalCase"
+	SnakeCase  NamingStyle = "snake_case"
+	KebabCase  NamingStyle = "kebab-case"
+
+	// ConsistentCase requires all names of a kind (e.g. all operationIds) to follow the same convention,
+	// whichever is the most used by the names of this kind in the spec.
+	ConsistentCase NamingStyle = "consistent"
+)
+
+// namingPattern is the pattern names must match to follow a naming convention.
+type namingPattern struct {
+	style   NamingStyle
+	pattern *regexp.Regexp
+}
+
+// namingPatterns lists the patterns of every naming convention.
+//
+// The order of the styles tells which style is preferred by ConsistentCase, when several are equally used.
+var namingPatterns = []namingPattern{
+	{CamelCase, regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)},
+	{SnakeCase, regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)},
+	{KebabCase, regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)},
+	{PascalCase, regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)},
+}
+
+// Conventions is a set of naming and style conventions, checked by the spec validator when
+// configured in the Conventions option. Violations are reported as warnings.
+type Conventions struct {
+	OperationIDs NamingStyle // naming convention for operationIds
+	Definitions  NamingStyle // naming convention for the names of definitions
+	Properties   NamingStyle // naming convention for the properties of every schema
+	PathSegments NamingStyle // naming convention for the non-templated segments of paths
+
+	// DeclaredTags requires the tags used by operations to be declared in the top-level tags.
+	DeclaredTags bool
+
+	// UniqueTags requires the top-level tags to be declared once.
+	UniqueTags bool
+}
+
+// DefaultConventions returns a commonly used set of conventions: operationIds in camelCase,
+// definitions in PascalCase, consistent property names, path segments in kebab-case, and tags
+// which are declared once in the top-level tags.
+func DefaultConventions() *Conventions {
+	return &Conventions{
+		OperationIDs: CamelCase,
+		Definitions:  PascalCase,
+		Properties:   ConsistentCase,
+		PathSegments: KebabCase,
+		DeclaredTags: true,
+		UniqueTags:   true,
+	}
+}
+
+// followsNamingStyle tells if a name follows a naming convention.
+func followsNamingStyle(name string, style NamingStyle) bool {
+	for _, naming := range namingPatterns {
+		if naming.style == style {
+			return naming.pattern.MatchString(name)
+		}
+	}
+
+	// unknown conventions are not checked
+	return true
+}
+
+// prevailingNamingStyle returns the naming convention followed by most names.
+func prevailingNamingStyle(names []string) NamingStyle {
+	prevailing, most := namingPatterns[0].style, 0
+	for _, naming := range namingPatterns {
+		count := 0
+		for _, name := range names {
+			if naming.pattern.MatchString(name) {
+				count++
+			}
+		}
+
+		if count > most {
+			prevailing, most = naming.style, count
+		}
+	}
+
+	return prevailing
+}
+
+// resolvedNamingStyle returns the naming convention names must follow: ConsistentCase stands for the
+// convention followed by most names.
+func resolvedNamingStyle(style NamingStyle, names []string) NamingStyle {
+	if style == ConsistentCase {
+		return prevailingNamingStyle(names)
+	}
+
+	return style
+}
+
+// validateConventions checks the naming and style conventions configured in the Conventions option.
+func (s *SpecValidator) validateConventions() *Result {
+	res := pools.poolOfResults.BorrowResult()
+	conventions := s.Options.Conventions
+	sw := s.spec.Spec()
+
+	if style := conventions.OperationIDs; style != "" {
+		operationIDs := make(map[string]string) // JSON pointer to the first operation, by operationId
+		for method, pathItem := range s.analyzer.Operations() {
+			for path, op := range pathItem {
+				if op.ID == "" {
+					continue
+				}
+
+				pointer := operationPointer(method, path)
+				if first, ok := operationIDs[op.ID]; !ok || pointer < first {
+					operationIDs[op.ID] = pointer
+				}
+			}
+		}
+
+		names := slices.Sorted(maps.Keys(operationIDs))
+		style = resolvedNamingStyle(style, names)
+		for _, operationID := range names {
+			if !followsNamingStyle(operationID, style) {
+				res.AddWarnings(FindingAt(operationIDs[operationID], namingConventionMsg("operationId", operationID, string(style))))
+			}
+		}
+	}
+
+	if style := conventions.Definitions; style != "" {
+		names := slices.Sorted(maps.Keys(sw.Definitions))
+		style = resolvedNamingStyle(style, names)
+		for _, name := range names {
+			if !followsNamingStyle(name, style) {
+				res.AddWarnings(FindingAt(definitionPointer(name), namingConventionMsg("definition", name, string(style))))
+			}
+		}
+	}
+
+	if style := conventions.Properties; style != "" {
+		res.Merge(s.validatePropertyNames(style))
+	}
+
+	if style := conventions.PathSegments; style != "" {
+		segments := make(map[string][]string) // non-templated segments, by path
+		var names []string
+		for _, path := range slices.Sorted(maps.Keys(s.analyzer.AllPaths())) {
+			for segment := range strings.SplitSeq(path, "/") {
+				if segment == "" || strings.ContainsAny(segment, "{}") {
+					continue
+				}
+
+				segments[path] = append(segments[path], segment)
+				names = append(names, segment)
+			}
+		}
+
+		style = resolvedNamingStyle(style, names)
+		for _, path := range slices.Sorted(maps.Keys(segments)) {
+			for _, segment := range segments[path] {
+				if !followsNamingStyle(segment, style) {
+					res.AddWarnings(FindingAt(pathPointer(path), pathSegmentConventionMsg(path, segment, string(style))))
+				}
+			}
+		}
+	}
+
+	if conventions.UniqueTags {
+		declarations := make(map[string]int, len(sw.Tags))
+		firsts := make(map[string]int, len(sw.Tags)) // index of the first declaration, by tag name
+		for i, tag := range sw.Tags {
+			if declarations[tag.Name] == 0 {
+				firsts[tag.Name] = i
+			}
+			declarations[tag.Name]++
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(declarations)) {
+			if count := declarations[name]; count > 1 {
+				res.AddWarnings(FindingAt("/tags/"+strconv.Itoa(firsts[name]), duplicateTagMsg(name, count)))
+			}
+		}
+	}
+
+	if conventions.DeclaredTags {
+		declared := make(map[string]struct{}, len(sw.Tags))
+		for _, tag := range sw.Tags {
+			declared[tag.Name] = struct{}{}
+		}
+
+		for method, pathItem := range s.analyzer.Operations() {
+			for path, op := range pathItem {
+				operation := op.ID
+				if operation == "" {
+					operation = fmt.Sprintf("%s %s", method, path)
+				}
+
+				for _, tag := range op.Tags {
+					if _, isDeclared := declared[tag]; !isDeclared {
+						res.AddWarnings(FindingAt(operationPointer(method, path)+"/tags", undeclaredTagMsg(operation, tag)))
+					}
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// validatePropertyNames checks the names of the properties of every schema in the spec,
+// including inline schemas in parameters and responses.
+func (s *SpecValidator) validatePropertyNames(style NamingStyle) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	properties := make(map[string][]string) // property names, by JSON pointer to their schema
+	var names []string
+	for _, schemaRef := range s.analyzer.AllDefinitions() {
+		if len(schemaRef.Schema.Properties) == 0 {
+			continue
+		}
+
+		pointer := schemaRef.Ref.String()
+		properties[pointer] = slices.Sorted(maps.Keys(schemaRef.Schema.Properties))
+		names = append(names, properties[pointer]...)
+	}
+
+	style = resolvedNamingStyle(style, names)
+	for _, pointer := range slices.Sorted(maps.Keys(properties)) {
+		for _, name := range properties[pointer] {
+			if !followsNamingStyle(name, style) {
+				res.AddWarnings(FindingAt(
+					strings.TrimPrefix(pointer, "#")+"/properties/"+jsonpointer.Escape(name),
+					propertyNamingConventionMsg(name, pointer, string(style)),
+				))
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestFollowsNamingStyle(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		style    NamingStyle
+		expected bool
+	}{
+		{"getUsers", CamelCase, true},
+		{"GetUsers", CamelCase, false},
+		{"get_users", CamelCase, false},
+		{"User", PascalCase, true},
+		{"user", PascalCase, false},
+		{"first_name", SnakeCase, true},
+		{"firstName", SnakeCase, false},
+		{"user-groups", KebabCase, true},
+		{"userGroups", KebabCase, false},
+		{"user--groups", KebabCase, false},
+		{"anything", NamingStyle("unknown"), true},
+	} {
+		assert.EqualT(t, tc.expected, followsNamingStyle(tc.name, tc.style), "%s in %s", tc.name, tc.style)
+	}
+}
+
+func TestPrevailingNamingStyle(t *testing.T) {
+	assert.EqualT(t, SnakeCase, prevailingNamingStyle([]string{"id", "first_name", "last_name", "birthDate"}))
+	assert.EqualT(t, CamelCase, prevailingNamingStyle([]string{"id", "firstName", "last_name"}))
+	assert.EqualT(t, CamelCase, prevailingNamingStyle(nil))
+}
+
+func TestSpec_ValidateConventions(t *testing.T) {
+	t.Run("should not check conventions by default", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-conventions.yaml")
+		require.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should warn about the default conventions", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-conventions.yaml", func(o *Opts) { o.Conventions = DefaultConventions() })
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`operationId "AddGroup" does not follow the camelCase naming convention`,
+			`definition "user_info" does not follow the PascalCase naming convention`,
+			`property "group_name" in #/paths/~1userGroups/post/parameters/0/schema does not follow the camelCase naming convention`,
+			`path "/userGroups" has a segment "userGroups" which does not follow the kebab-case naming convention`,
+			`tag "users" is declared 2 times in the top-level tags`,
+			`operation "AddGroup" uses the tag "groups", which is not declared in the top-level tags`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+	})
+
+	t.Run("should warn about the configured conventions", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-conventions.yaml", func(o *Opts) { o.Conventions = &Conventions{Properties: SnakeCase} })
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`property "firstName" in #/definitions/User does not follow the snake_case naming convention`,
+			`property "lastName" in #/definitions/User does not follow the snake_case naming convention`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+	})
+
+	t.Run("should warn about the names which are not consistent with the names of the same kind", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-conventions.yaml", func(o *Opts) {
+			o.Conventions = &Conventions{
+				OperationIDs: ConsistentCase,
+				Definitions:  ConsistentCase,
+				Properties:   ConsistentCase,
+				PathSegments: ConsistentCase,
+			}
+		})
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`operationId "AddGroup" does not follow the camelCase naming convention`,
+			`definition "User" does not follow the snake_case naming convention`,
+			`property "group_name" in #/paths/~1userGroups/post/parameters/0/schema does not follow the camelCase naming convention`,
+			`path "/user-groups/{groupId}/members" has a segment "user-groups" which does not follow the camelCase naming convention`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+	})
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/spec"
+)
+
+// documentationValidator reports the parts of a spec which are not documented:
+//
+//   - operations without summary or description
+//   - parameters and responses without description
+//   - definitions without title or description
+//   - info without contact or license
+//   - externalDocs with an invalid url
+//
+// It is enabled by the DocumentationLint option. The completeness of the documentation
+// of every operation and definition is exposed by [Result.Completeness].
+type documentationValidator struct {
+	SpecValidator *SpecValidator
+}
+
+// fullCompleteness is the completeness of a fully documented operation or definition, in percent.
+const fullCompleteness = 100.0
+
+// docCounter counts the documented items of an operation or a definition.
+type docCounter struct {
+	documented int
+	total      int
+}
+
+// check counts an item, and tells if it is documented.
+func (c *docCounter) check(text string) bool {
+	c.total++
+	if strings.TrimSpace(text) == "" {
+		return false
+	}
+	c.documented++
+
+	return true
+}
+
+// percent returns the proportion of documented items, in percent.
+func (c docCounter) percent() float64 {
+	if c.total == 0 {
+		return fullCompleteness
+	}
+
+	return float64(c.documented) * fullCompleteness / float64(c.total)
+}
+
+// Validate walks the operations of the expanded spec, like the defaultValidator, and the definitions of the spec,
+// and reports undocumented items as warnings.
+func (d *documentationValidator) Validate() *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	if d == nil || d.SpecValidator == nil {
+		return res
+	}
+
+	s := d.SpecValidator
+	sw := s.spec.Spec()
+
+	if sw.Info != nil {
+		if sw.Info.Contact == nil {
+			res.AddWarnings(FindingAt("/info", undocumentedInfoMsg("contact")))
+		}
+		if sw.Info.License == nil {
+			res.AddWarnings(FindingAt("/info", undocumentedInfoMsg("license")))
+		}
+	}
+
+	d.validateExternalDocs("the spec", "", sw.ExternalDocs, res)
+	for i, tag := range sw.Tags {
+		d.validateExternalDocs(fmt.Sprintf("tag %q", tag.Name), "/tags/"+strconv.Itoa(i), tag.ExternalDocs, res)
+	}
+
+	counters := make(map[string]*docCounter)
+	s.walkOperations(res, operationVisitor{
+		operation: func(method, path string, op *spec.Operation) {
+			operation := operationName(method, path, op)
+			counter := &docCounter{}
+			counters[operation] = counter
+
+			pointer := operationPointer(method, path)
+			if !counter.check(op.Summary) {
+				res.AddWarnings(FindingAt(pointer, undocumentedOperationMsg(operation, "summary")))
+			}
+			if !counter.check(op.Description) {
+				res.AddWarnings(FindingAt(pointer, undocumentedOperationMsg(operation, "description")))
+			}
+			d.validateExternalDocs(fmt.Sprintf("operation %q", operation), pointer, op.ExternalDocs, res)
+		},
+		param: func(method, path string, op *spec.Operation, param *spec.Parameter) {
+			operation := operationName(method, path, op)
+			if !counters[operation].check(param.Description) {
+				res.AddWarnings(FindingAt(s.paramPointer(method, path, param), undocumentedParamMsg(operation, param.Name, param.In)))
+			}
+		},
+		response: func(method, path string, op *spec.Operation, response *spec.Response, responseType string, responseCode int) {
+			operation := operationName(method, path, op)
+			if !counters[operation].check(response.Description) {
+				responseName, _ := responseHelp.responseMsgVariants(responseType, responseCode)
+				res.AddWarnings(FindingAt(
+					responsePointer(method, path, responseType, responseCode),
+					undocumentedResponseMsg(operation, responseName),
+				))
+			}
+		},
+	})
+
+	for operation, counter := range counters {
+		res.setCompleteness("operation "+operation, counter.percent())
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(sw.Definitions)) {
+		definition := sw.Definitions[name]
+
+		var counter docCounter
+		if !counter.check(definition.Title) {
+			res.AddWarnings(FindingAt(definitionPointer(name), undocumentedDefinitionMsg(name, "title")))
+		}
+		if !counter.check(definition.Description) {
+			res.AddWarnings(FindingAt(definitionPointer(name), undocumentedDefinitionMsg(name, "description")))
+		}
+
+		res.setCompleteness("definition "+name, counter.percent())
+	}
+
+	schemas := s.analyzer.AllDefinitions()
+	slices.SortFunc(schemas, func(a, b analysis.SchemaRef) int { return strings.Compare(a.Ref.String(), b.Ref.String()) })
+	for _, schemaRef := range schemas {
+		pointer := schemaRef.Ref.String()
+		d.validateExternalDocs(pointer, strings.TrimPrefix(pointer, "#"), schemaRef.Schema.ExternalDocs, res)
+	}
+
+	return res
+}
+
+// validateExternalDocs checks that the url of some external documentation is an absolute URL.
+// The documented object is located by its JSON pointer.
+//
+// A missing url is reported when validating the spec against the Swagger 2.0 schema.
+func (d *documentationValidator) validateExternalDocs(where, pointer string, docs *spec.ExternalDocumentation, res *Result) {
+	if docs == nil || docs.URL == "" {
+		return
+	}
+
+	if u, err := url.Parse(docs.URL); err != nil || u.Scheme == "" || u.Host == "" {
+		res.AddWarnings(FindingAt(pointer+"/externalDocs", invalidExternalDocsURLMsg(where, docs.URL)))
+	}
+}
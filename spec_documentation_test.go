@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_ValidateDocumentation(t *testing.T) {
+	t.Run("should not lint documentation by default", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-documentation.yaml")
+		require.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+		assert.Empty(t, res.Completeness())
+	})
+
+	t.Run("should warn about undocumented items", func(t *testing.T) {
+		res, warningsRes := loadFixtureAndValidate(t, "fixture-documentation.yaml", func(o *Opts) { o.DocumentationLint = true })
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`info has no contact`,
+			`externalDocs in tag "users" have an invalid url "/docs/users"`,
+			`operation "getUser" has no description`,
+			`in operation "getUser", param "id" in path has no description`,
+			`in operation "getUser", default response has no description`,
+			`definition "Group" has no title`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+
+		assert.Equal(t, map[string]float64{
+			"operation getUser": 50,
+			"definition User":   100,
+			"definition Group":  50,
+		}, res.Completeness())
+		assert.Equal(t, res.Completeness(), warningsRes.Completeness())
+	})
+}
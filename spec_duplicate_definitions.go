@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+)
+
+// validateDuplicateDefinitions reports definitions which are structurally identical, e.g. PetResponse and Pet2,
+// and suggests the one to keep: the most referenced one, then the one with the shortest name.
+//
+// Definitions are compared in a canonical form, regardless of the order of their keys and of the order of
+// required properties and enum values. With the
+// DuplicateDefinitionsIgnoreDocs option, titles and descriptions are ignored.
+//
+// The check is carried out on the raw document. It is enabled by the DuplicateDefinitions option.
+func (s *SpecValidator) validateDuplicateDefinitions(doc any) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	definitions := asObject(asObject(doc)["definitions"])
+	if len(definitions) < 2 {
+		return res
+	}
+
+	groups := make(map[string][]string, len(definitions))
+	for _, name := range sortedKeys(definitions) {
+		canonical, err := json.Marshal(canonicalSchema(definitions[name], s.Options.DuplicateDefinitionsIgnoreDocs))
+		if err != nil {
+			// invalid values are reported when validating the spec against the Swagger 2.0 schema
+			continue
+		}
+
+		groups[string(canonical)] = append(groups[string(canonical)], name)
+	}
+
+	references := make(map[string]int, len(definitions))
+	for _, ref := range s.analyzer.AllDefinitionReferences() {
+		if target, isLocal := strings.CutPrefix(ref, definitionsPrefix); isLocal {
+			references[jsonpointer.Unescape(target)]++
+		}
+	}
+
+	duplicates := make([][]string, 0, len(groups))
+	for _, names := range groups {
+		if len(names) > 1 {
+			duplicates = append(duplicates, names)
+		}
+	}
+	slices.SortFunc(duplicates, func(a, b []string) int { return strings.Compare(a[0], b[0]) })
+
+	for _, names := range duplicates {
+		keep := slices.MinFunc(names, func(a, b string) int {
+			switch {
+			case references[a] != references[b]:
+				return references[b] - references[a]
+			case len(a) != len(b):
+				return len(a) - len(b)
+			default:
+				return strings.Compare(a, b)
+			}
+		})
+
+		quoted := make([]string, 0, len(names))
+		for _, name := range names {
+			quoted = append(quoted, strconv.Quote(name))
+		}
+		// duplicates are located at the first definition which is not kept
+		drop := names[0]
+		if drop == keep {
+			drop = names[1]
+		}
+		res.AddWarnings(FindingAt(definitionPointer(drop), duplicateDefinitionsMsg(strings.Join(quoted, ", "), keep)))
+	}
+
+	return res
+}
+
+// canonicalSchema returns a copy of a raw schema in a canonical form: required and enum are sorted, and titles
+// and descriptions are removed when ignoreDocs is enabled.
+//
+// The schemas it contains are found with walkSpec, so that a property named "title" or "description" is kept.
+// Vendor extensions are compared, since they may change how a definition is used, e.g. x-nullable or x-go-name.
+func canonicalSchema(node any, ignoreDocs bool) any {
+	canonical := cloneRaw(node)
+
+	walkSpec(canonical, "", specKindSchema, func(_ string, _ specKind, object map[string]any) bool {
+		if ignoreDocs {
+			delete(object, "title")
+			delete(object, "description")
+		}
+
+		for _, key := range []string{"required", "enum"} {
+			if values, isArray := object[key].([]any); isArray {
+				slices.SortStableFunc(values, compareRaw)
+			}
+		}
+
+		return true
+	})
+
+	return canonical
+}
+
+// cloneRaw returns a deep copy of a raw JSON value.
+func cloneRaw(node any) any {
+	switch value := node.(type) {
+	case map[string]any:
+		clone := make(map[string]any, len(value))
+		for key, child := range value {
+			clone[key] = cloneRaw(child)
+		}
+
+		return clone
+
+	case []any:
+		clone := make([]any, 0, len(value))
+		for _, child := range value {
+			clone = append(clone, cloneRaw(child))
+		}
+
+		return clone
+
+	default:
+		return value
+	}
+}
+
+// compareRaw orders raw JSON values by their JSON encoding.
+func compareRaw(a, b any) int {
+	encodedA, _ := json.Marshal(a)
+	encodedB, _ := json.Marshal(b)
+
+	return bytes.Compare(encodedA, encodedB)
+}
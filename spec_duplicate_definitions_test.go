@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestCanonicalSchema(t *testing.T) {
+	schema := map[string]any{
+		"title":       "Pet",
+		"description": "A pet",
+		"type":        "object",
+		"properties": map[string]any{
+			"title": map[string]any{"type": "string", "description": "the title of the pet"},
+		},
+		"items": []any{map[string]any{"type": "string", "title": "first"}},
+	}
+
+	assert.Equal(t, schema, canonicalSchema(schema, false))
+	assert.Equal(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"title": map[string]any{"type": "string"},
+		},
+		"items": []any{map[string]any{"type": "string"}},
+	}, canonicalSchema(schema, true))
+
+	t.Run("should sort required properties and enum values", func(t *testing.T) {
+		unordered := map[string]any{
+			"type":     "object",
+			"required": []any{"name", "id"},
+			"properties": map[string]any{
+				"id":   map[string]any{"type": "integer"},
+				"name": map[string]any{"type": "string", "enum": []any{"dog", "cat"}},
+			},
+		}
+
+		assert.Equal(t, map[string]any{
+			"type":     "object",
+			"required": []any{"id", "name"},
+			"properties": map[string]any{
+				"id":   map[string]any{"type": "integer"},
+				"name": map[string]any{"type": "string", "enum": []any{"cat", "dog"}},
+			},
+		}, canonicalSchema(unordered, false))
+
+		// the schema is not modified
+		assert.Equal(t, []any{"name", "id"}, unordered["required"])
+	})
+}
+
+func TestSpec_ValidateDuplicateDefinitions(t *testing.T) {
+	t.Run("should not report duplicate definitions by default", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-duplicate-definitions.yaml")
+		require.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should report structurally identical definitions", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-duplicate-definitions.yaml", func(o *Opts) { o.DuplicateDefinitions = true })
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`definitions "Pet2", "PetResponse" are structurally identical: consider keeping only "PetResponse"`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+	})
+
+	t.Run("should ignore titles and descriptions", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-duplicate-definitions.yaml", func(o *Opts) {
+			o.DuplicateDefinitions = true
+			o.DuplicateDefinitionsIgnoreDocs = true
+		})
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`definitions "Label", "Tag" are structurally identical: consider keeping only "Tag"`,
+			`definitions "Pet", "Pet2", "PetResponse" are structurally identical: consider keeping only "PetResponse"`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+	})
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+// ExtensionKind is the kind of object a vendor extension may be declared on.
+type ExtensionKind string
+
+// Kinds of objects which may declare vendor extensions.
+const (
+	ExtensionOnRoot           ExtensionKind = "swagger"
+	ExtensionOnInfo           ExtensionKind = "info"
+	ExtensionOnPaths          ExtensionKind = "paths"
+	ExtensionOnPathItem       ExtensionKind = "path item"
+	ExtensionOnOperation      ExtensionKind = "operation"
+	ExtensionOnParameter      ExtensionKind = "parameter"
+	ExtensionOnItems          ExtensionKind = "items"
+	ExtensionOnResponses      ExtensionKind = "responses"
+	ExtensionOnResponse       ExtensionKind = "response"
+	ExtensionOnHeader         ExtensionKind = "header"
+	ExtensionOnSchema         ExtensionKind = "schema"
+	ExtensionOnTag            ExtensionKind = "tag"
+	ExtensionOnSecurityScheme ExtensionKind = "security scheme"
+	ExtensionOnExternalDocs   ExtensionKind = "external docs"
+)
+
+// knownExtensions lists the vendor extensions which are known without being registered:
+// the suppressions of the validator, and the extensions interpreted by go-swagger.
+var knownExtensions = []string{xValidateIgnore, xExample, xGoName, xGoType, xIsNullable, xNullable, xOmitEmpty}
+
+// ExtensionSchema is the JSON schema registered to validate the values of a vendor extension.
+type ExtensionSchema struct {
+	// Schema validates every value of the extension
+	Schema *spec.Schema
+
+	// Kinds restricts the objects on which the extension may be declared. When empty, the extension
+	// may be declared on any object.
+	Kinds []ExtensionKind
+}
+
+// RegisterExtension registers a JSON schema to validate the values of a vendor extension (e.g. x-rate-limit),
+// optionally restricted to some kinds of objects.
+//
+// The ExtensionSchemas option is copied before registering, since it may be shared with other validators.
+func (s *SpecValidator) RegisterExtension(name string, schema *spec.Schema, kinds ...ExtensionKind) {
+	registry := make(map[string]ExtensionSchema, len(s.Options.ExtensionSchemas)+1)
+	maps.Copy(registry, s.Options.ExtensionSchemas)
+	registry[strings.ToLower(name)] = ExtensionSchema{Schema: schema, Kinds: kinds}
+
+	s.Options.ExtensionSchemas = registry
+}
+
+// validateVendorExtensions validates every vendor extension registered in the ExtensionSchemas option
+// against its schema, and checks that it is declared on the expected kinds of objects.
+//
+// With the StrictExtensions option, extensions which are not registered are reported as errors. The suppressions
+// of the validator and the extensions interpreted by go-swagger (e.g. x-nullable, x-go-name) are known.
+//
+// The check is carried out on the raw document, so every object declaring extensions is visited.
+func (s *SpecValidator) validateVendorExtensions(doc any) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	if len(s.Options.ExtensionSchemas) == 0 && !s.Options.StrictExtensions {
+		return res
+	}
+
+	registry := make(map[string]ExtensionSchema, len(knownExtensions)+len(s.Options.ExtensionSchemas))
+	for _, name := range knownExtensions {
+		registry[name] = ExtensionSchema{}
+	}
+	for name, registered := range s.Options.ExtensionSchemas {
+		registry[strings.ToLower(name)] = registered
+	}
+
+	walkExtensions(doc, func(pointer string, kind ExtensionKind, name string, value any) {
+		extension := pointer + "/" + jsonpointer.Escape(name)
+		registered, known := registry[strings.ToLower(name)]
+		switch {
+		case !known:
+			if s.Options.StrictExtensions {
+				res.AddErrors(FindingAt(extension, unknownExtensionMsg("#"+pointer, name)))
+			}
+
+			return
+		case len(registered.Kinds) > 0 && !slices.Contains(registered.Kinds, kind):
+			expected := make([]string, 0, len(registered.Kinds))
+			for _, k := range registered.Kinds {
+				expected = append(expected, string(k))
+			}
+			msg := extensionNotAllowedMsg("#"+pointer, name, string(kind), strings.Join(expected, ", "))
+			res.AddErrors(FindingAt(extension, msg))
+
+			return
+		case registered.Schema == nil:
+			return
+		}
+
+		red := NewSchemaValidator(registered.Schema, registered.Schema, name, s.KnownFormats).Validate(value)
+		if red.HasErrorsOrWarnings() {
+			res.AddErrors(FindingAt(extension, extensionDoesNotValidateMsg("#"+pointer, name)))
+			res.Merge(red.locate(extension))
+		} else if red.wantsRedeemOnMerge {
+			pools.poolOfResults.RedeemResult(red)
+		}
+	})
+
+	return res
+}
+
+// walkExtensions calls visit for every vendor extension declared in a swagger document, with the JSON pointer
+// and the kind of the object declaring it.
+func walkExtensions(doc any, visit func(pointer string, kind ExtensionKind, name string, value any)) {
+	walkSpec(doc, "", specKindRoot, func(pointer string, kind specKind, object map[string]any) bool {
+		for _, key := range sortedKeys(object) {
+			if isExtension(key) {
+				visit(pointer, extensionKindOf(kind), key, object[key])
+			}
+		}
+
+		return true
+	})
+}
+
+// extensionKindOf returns the kind of an object of the spec, as known to registered extensions.
+func extensionKindOf(kind specKind) ExtensionKind {
+	switch kind {
+	case specKindInfo:
+		return ExtensionOnInfo
+	case specKindExternalDocs:
+		return ExtensionOnExternalDocs
+	case specKindTag:
+		return ExtensionOnTag
+	case specKindSecurityScheme:
+		return ExtensionOnSecurityScheme
+	case specKindPaths:
+		return ExtensionOnPaths
+	case specKindPathItem:
+		return ExtensionOnPathItem
+	case specKindOperation:
+		return ExtensionOnOperation
+	case specKindParameter:
+		return ExtensionOnParameter
+	case specKindItems:
+		return ExtensionOnItems
+	case specKindResponses:
+		return ExtensionOnResponses
+	case specKindResponse:
+		return ExtensionOnResponse
+	case specKindHeader:
+		return ExtensionOnHeader
+	case specKindSchema:
+		return ExtensionOnSchema
+	default:
+		return ExtensionOnRoot
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_ValidateVendorExtensions(t *testing.T) {
+	var rateLimit, owner spec.Schema
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "object",
+		"required": ["limit"],
+		"properties": {"limit": {"type": "integer"}, "window": {"type": "string"}},
+		"additionalProperties": false
+	}`), &rateLimit))
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "object",
+		"required": ["team"],
+		"properties": {"team": {"type": "string"}, "email": {"type": "string", "format": "email"}}
+	}`), &owner))
+
+	registry := func(o *Opts) {
+		o.ExtensionSchemas = map[string]ExtensionSchema{
+			"x-rate-limit": {Schema: &rateLimit, Kinds: []ExtensionKind{ExtensionOnOperation}},
+			"X-Owner":      {Schema: &owner},
+		}
+	}
+
+	t.Run("should validate registered extensions", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-extensions.yaml", registry)
+		require.FalseT(t, res.IsValid())
+
+		errs := verifiedTestErrors(res)
+		for _, msg := range []string{
+			`in #/paths/~1pets/post, extension x-rate-limit does not validate its schema`,
+			`x-rate-limit.limit in body must be of type integer: "string"`,
+			`in #/paths/~1pets/get/parameters/0, extension x-rate-limit is not allowed on a parameter: expected on operation`,
+			`in #, extension x-owner does not validate its schema`,
+			`x-owner.team in body is required`,
+		} {
+			assert.SliceContainsT(t, errs, msg)
+		}
+		assert.Len(t, errs, 5)
+	})
+
+	t.Run("should reject unknown extensions in strict mode", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-extensions.yaml", registry, func(o *Opts) { o.StrictExtensions = true })
+		require.FalseT(t, res.IsValid())
+
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs, `in #/paths/~1pets/get/responses/200, extension x-cache is not registered`)
+		assert.SliceContainsT(t, errs, `in #/definitions/pet/properties/x-tag, extension x-internal is not registered`)
+		assert.SliceContainsT(t, errs, `in #/paths/~1pets/get/parameters/1/items, extension x-max-tags is not registered`)
+		assert.SliceContainsT(t, errs, `in #/externalDocs, extension x-reviewed is not registered`)
+		assert.SliceContainsT(t, errs, `in #/paths, extension x-paths-owner is not registered`)
+		assert.SliceContainsT(t, errs, `in #/paths/~1pets/get/responses, extension x-responses-owner is not registered`)
+		// the extensions interpreted by go-swagger are known
+		assert.Len(t, errs, 11)
+	})
+
+	t.Run("should register extensions on the validator", func(t *testing.T) {
+		validator := NewSpecValidator(spec.MustLoadSwagger20Schema(), nil)
+		validator.RegisterExtension("X-Rate-Limit", &rateLimit, ExtensionOnOperation, ExtensionOnPathItem)
+		require.Contains(t, validator.Options.ExtensionSchemas, "x-rate-limit")
+		assert.Equal(t, []ExtensionKind{ExtensionOnOperation, ExtensionOnPathItem}, validator.Options.ExtensionSchemas["x-rate-limit"].Kinds)
+	})
+
+	t.Run("should not register extensions on a shared registry", func(t *testing.T) {
+		shared := map[string]ExtensionSchema{"x-owner": {Schema: &owner}}
+		validator := NewSpecValidator(spec.MustLoadSwagger20Schema(), nil)
+		validator.Options.ExtensionSchemas = shared
+
+		validator.RegisterExtension("x-rate-limit", &rateLimit)
+		assert.Len(t, validator.Options.ExtensionSchemas, 2)
+		assert.Len(t, shared, 1)
+	})
+}
@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"go/token"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/swag/mangling"
+)
+
+// Vendor extensions interpreted by go-swagger when generating code.
+const (
+	xGoName     = "x-go-name"
+	xGoType     = "x-go-type"
+	xOmitEmpty  = "x-omitempty"
+	xNullable   = "x-nullable"
+	xIsNullable = "x-isnullable"
+)
+
+// goSwaggerValidator checks that a spec may be used to generate go code with go-swagger.
+//
+// It is enabled by the GoSwaggerMode option.
+type goSwaggerValidator struct {
+	SpecValidator *SpecValidator
+	mangler       mangling.NameMangler
+}
+
+// Validate reports the constructs which would break code generation:
+//
+//   - definitions, operationIds, parameters of an operation or properties of a schema
+//     which map to the same go identifier
+//   - names which map to a reserved go keyword
+//   - invalid values for the x-go-name, x-go-type, x-omitempty and x-nullable extensions
+//
+// Vendor extensions and properties are checked on the raw document, so every object declaring them is visited.
+func (g *goSwaggerValidator) Validate(doc any) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	if g == nil || g.SpecValidator == nil {
+		return res
+	}
+
+	g.mangler = mangling.NewNameMangler()
+	sw := g.SpecValidator.spec.Spec()
+
+	definitions := make([]goNamed, 0, len(sw.Definitions))
+	for name, schema := range sw.Definitions {
+		definitions = append(definitions, goNamed{
+			name: name, label: strconv.Quote(name), pointer: definitionPointer(name), extensions: schema.Extensions,
+		})
+	}
+	g.validateGoNames("definitions", definitions, res)
+
+	// parameters at the root level are not in the same scope: only reserved keywords are checked
+	parameters := make([]goNamed, 0, len(sw.Parameters))
+	for key, param := range sw.Parameters {
+		parameters = append(parameters, goNamed{
+			name: param.Name, label: strconv.Quote(param.Name), pointer: "/parameters/" + jsonpointer.Escape(key), extensions: param.Extensions,
+		})
+	}
+	g.validateGoKeywords("parameters", parameters, res)
+
+	var operationIDs []goNamed
+	for method, pathItem := range g.SpecValidator.analyzer.Operations() {
+		for path, op := range pathItem {
+			if op.ID != "" {
+				operationIDs = append(operationIDs, goNamed{
+					name: op.ID, label: strconv.Quote(op.ID), pointer: operationPointer(method, path), extensions: op.Extensions,
+				})
+			}
+
+			where := fmt.Sprintf("%s %s", method, path)
+			if op.ID != "" {
+				where = fmt.Sprintf("operation %q", op.ID)
+			}
+			g.validateOperationParams(where, method, path, res)
+		}
+	}
+	g.validateGoNames("operationIds", operationIDs, res)
+
+	walkSpec(doc, "", specKindRoot, func(pointer string, kind specKind, object map[string]any) bool {
+		// $ref are not followed: the objects they refer to are validated where they are defined
+		if _, isRef := object["$ref"]; isRef && refTargetName(kind) != "" {
+			return false
+		}
+
+		switch kind {
+		case specKindOperation, specKindParameter, specKindItems, specKindResponse, specKindHeader:
+			g.validateExtensions(pointer, spec.Extensions(object), res)
+
+		case specKindSchema:
+			g.validateExtensions(pointer, spec.Extensions(object), res)
+
+			properties := asObject(object[jsonProperties])
+			named := make([]goNamed, 0, len(properties))
+			for name, property := range properties {
+				named = append(named, goNamed{
+					name: name, label: strconv.Quote(name), pointer: pointer + "/properties/" + jsonpointer.Escape(name),
+					extensions: spec.Extensions(asObject(property)),
+				})
+			}
+			g.validateGoNames("properties of #"+pointer, named, res)
+		}
+
+		return true
+	})
+
+	return res
+}
+
+// validateOperationParams checks the parameters of an operation, including the parameters declared at the
+// path level which the operation does not override.
+func (g *goSwaggerValidator) validateOperationParams(where, method, path string, res *Result) {
+	// unresolved parameters are reported elsewhere
+	params := g.SpecValidator.analyzer.SafeParamsFor(method, path, func(spec.Parameter, error) bool { return true })
+
+	named := make([]goNamed, 0, len(params))
+	for _, param := range params {
+		named = append(named, goNamed{
+			name: param.Name, label: fmt.Sprintf("%q in %s", param.Name, param.In),
+			pointer: g.SpecValidator.paramPointer(method, path, &param), extensions: param.Extensions,
+		})
+	}
+	g.validateGoNames("parameters in "+where, named, res)
+}
+
+// goNamed is a name from the spec which maps to a go identifier.
+type goNamed struct {
+	name       string
+	label      string // how the name is reported
+	pointer    string // JSON pointer to the object with this name
+	extensions spec.Extensions
+}
+
+// validateGoNames reports names which map to the same go identifier, or to a reserved go keyword.
+// A valid x-go-name takes precedence over the mangled name.
+//
+// Names which map to the same go identifier are located at the first of them.
+func (g *goSwaggerValidator) validateGoNames(what string, names []goNamed, res *Result) {
+	g.validateGoKeywords(what, names, res)
+
+	byGoName := make(map[string][]goNamed, len(names))
+	for _, named := range names {
+		goName := g.mangler.ToGoName(named.name)
+		if override, ok := goNameOverride(named.extensions); ok {
+			goName = override
+		}
+
+		byGoName[goName] = append(byGoName[goName], named)
+	}
+
+	for _, goName := range slices.Sorted(maps.Keys(byGoName)) {
+		colliding := byGoName[goName]
+		if len(colliding) < 2 {
+			continue
+		}
+
+		slices.SortFunc(colliding, func(a, b goNamed) int { return strings.Compare(a.label, b.label) })
+		labels := make([]string, 0, len(colliding))
+		for _, named := range colliding {
+			labels = append(labels, named.label)
+		}
+		res.AddErrors(FindingAt(colliding[0].pointer, goNameCollisionMsg(what, strings.Join(labels, ", "), goName)))
+	}
+}
+
+// validateGoKeywords reports names which map to a reserved go keyword, unless a valid x-go-name overrides them.
+func (g *goSwaggerValidator) validateGoKeywords(what string, names []goNamed, res *Result) {
+	sorted := slices.SortedFunc(slices.Values(names), func(a, b goNamed) int { return strings.Compare(a.name, b.name) })
+	for _, named := range sorted {
+		if _, ok := goNameOverride(named.extensions); ok {
+			continue
+		}
+
+		if keyword := g.mangler.ToVarName(named.name); token.IsKeyword(keyword) {
+			res.AddWarnings(FindingAt(named.pointer, goReservedKeywordMsg(what, named.name, keyword)))
+		}
+	}
+}
+
+// goNameOverride returns the value of a valid x-go-name extension.
+func goNameOverride(extensions spec.Extensions) (string, bool) {
+	value, ok := extensionValue(extensions, xGoName)
+	if !ok {
+		return "", false
+	}
+
+	override, isString := value.(string)
+	if !isString || !token.IsIdentifier(override) {
+		return "", false
+	}
+
+	return override, true
+}
+
+// validateExtensions checks the values of the vendor extensions interpreted by go-swagger, on the object at some JSON pointer.
+func (g *goSwaggerValidator) validateExtensions(pointer string, extensions spec.Extensions, res *Result) {
+	where := "#" + pointer
+	red := pools.poolOfResults.BorrowResult()
+
+	if value, ok := extensionValue(extensions, xGoName); ok {
+		name, isString := value.(string)
+		switch {
+		case !isString:
+			red.AddErrors(invalidGoExtensionMsg(where, xGoName, "expected a string"))
+		case token.IsKeyword(name):
+			red.AddErrors(invalidGoExtensionMsg(where, xGoName, fmt.Sprintf("%q is a reserved go keyword", name)))
+		case !token.IsIdentifier(name):
+			red.AddErrors(invalidGoExtensionMsg(where, xGoName, fmt.Sprintf("%q is not a valid go identifier", name)))
+		}
+	}
+
+	if value, ok := extensionValue(extensions, xGoType); ok {
+		if reason := invalidGoType(value); reason != "" {
+			red.AddErrors(invalidGoExtensionMsg(where, xGoType, reason))
+		}
+	}
+
+	for _, extension := range []string{xOmitEmpty, xNullable} {
+		if value, ok := extensionValue(extensions, extension); ok {
+			if _, isBool := value.(bool); !isBool {
+				red.AddErrors(invalidGoExtensionMsg(where, extension, "expected a boolean"))
+			}
+		}
+	}
+
+	res.Merge(red.locate(pointer))
+}
+
+// invalidGoType explains why a x-go-type value is invalid. It returns an empty string for valid values.
+//
+// x-go-type is either the name of a go type, or an object like:
+//
+//	{"type": "Duration", "import": {"package": "time", "alias": "t"}, "embedded": false, "hints": {"kind": "primitive"}}
+func invalidGoType(value any) string {
+	switch goType := value.(type) {
+	case string:
+		if goType == "" {
+			return "the go type may not be empty"
+		}
+
+		return ""
+
+	case map[string]any:
+		if name, isString := goType["type"].(string); !isString || name == "" {
+			return "expected a non-empty type"
+		}
+
+		if imp, hasImport := goType["import"]; hasImport {
+			object, isObject := imp.(map[string]any)
+			if !isObject {
+				return "expected import to be an object"
+			}
+			if pkg, isString := object["package"].(string); !isString || pkg == "" {
+				return "expected a non-empty import package"
+			}
+			if alias, hasAlias := object["alias"]; hasAlias {
+				if name, isString := alias.(string); !isString || !token.IsIdentifier(name) || token.IsKeyword(name) {
+					return "expected the import alias to be a valid go identifier"
+				}
+			}
+		}
+
+		if embedded, hasEmbedded := goType["embedded"]; hasEmbedded {
+			if _, isBool := embedded.(bool); !isBool {
+				return "expected embedded to be a boolean"
+			}
+		}
+
+		if hints, hasHints := goType["hints"]; hasHints {
+			if _, isObject := hints.(map[string]any); !isObject {
+				return "expected hints to be an object"
+			}
+		}
+
+		return ""
+
+	default:
+		return "expected a string or an object"
+	}
+}
+
+// extensionValue returns the value of a vendor extension, looked up case-insensitively.
+func extensionValue(extensions spec.Extensions, name string) (any, bool) {
+	for key, value := range extensions {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
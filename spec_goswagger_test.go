@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestGoSwaggerValidator_Nil(t *testing.T) {
+	var g *goSwaggerValidator
+	res := g.Validate(nil)
+	assert.TrueT(t, res.IsValid())
+	assert.Empty(t, res.Warnings)
+}
+
+func TestSpec_GoSwaggerMode(t *testing.T) {
+	t.Run("should not check go-swagger constructs by default", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-goswagger.yaml")
+		require.TrueT(t, res.IsValid())
+	})
+
+	t.Run("should report constructs which break code generation", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-goswagger.yaml", func(o *Opts) { o.GoSwaggerMode = true })
+		require.FalseT(t, res.IsValid())
+
+		errs := verifiedTestErrors(res)
+		for _, msg := range []string{
+			`definitions "PetItem", "pet_item" map to the same go identifier "PetItem"`,
+			`operationIds "getPet", "get_pet" map to the same go identifier "GetPet"`,
+			`parameters in operation "get_pet" "Id" in query, "id" in path map to the same go identifier "ID"`,
+			`properties of #/definitions/pet_item "owner", "pet-name", "petName" map to the same go identifier "PetName"`,
+			`in #/paths/~1pets~1{id}/get/parameters/3, x-go-name is invalid: "func" is a reserved go keyword`,
+			`in #/definitions/pet_item/properties/petName, x-nullable is invalid: expected a boolean`,
+			`in #/definitions/pet_item/properties/tags, x-omitempty is invalid: expected a boolean`,
+			`in #/definitions/PetItem, x-go-type is invalid: expected the import alias to be a valid go identifier`,
+			`in #/definitions/Renamed, x-go-name is invalid: "2fast" is not a valid go identifier`,
+		} {
+			assert.SliceContainsT(t, errs, msg)
+		}
+		assert.Len(t, errs, 9)
+
+		assert.SliceContainsT(t, verifiedTestWarnings(res),
+			`in parameters in operation "get_pet", "type" maps to the reserved go keyword "type"`)
+	})
+
+	t.Run("should check the parameters declared at the path level and every name", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-goswagger-path-level.yaml", func(o *Opts) { o.GoSwaggerMode = true })
+		require.FalseT(t, res.IsValid())
+
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs,
+			`parameters in operation "getUser" "userId" in header, "user_id" in query map to the same go identifier "UserID"`)
+		assert.Len(t, errs, 1)
+
+		warnings := verifiedTestWarnings(res)
+		for _, msg := range []string{
+			`in definitions, "type" maps to the reserved go keyword "type"`,
+			`in parameters, "range" maps to the reserved go keyword "range"`,
+			`in properties of #/definitions/type, "func" maps to the reserved go keyword "func"`,
+		} {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		// x-go-name overrides the reserved keyword
+		assert.NotContains(t, warnings, `in properties of #/definitions/type, "chan" maps to the reserved go keyword "chan"`)
+	})
+}
+
+func TestInvalidGoType(t *testing.T) {
+	for _, valid := range []any{
+		"time.Duration",
+		map[string]any{"type": "Duration", "import": map[string]any{"package": "time", "alias": "t"}, "embedded": true},
+	} {
+		assert.Empty(t, invalidGoType(valid))
+	}
+
+	for value, reason := range map[string]string{
+		`{"import": {"package": "time"}}`:         "expected a non-empty type",
+		`{"type": "Duration", "import": "time"}`:  "expected import to be an object",
+		`{"type": "Duration", "import": {}}`:      "expected a non-empty import package",
+		`{"type": "Duration", "embedded": "yes"}`: "expected embedded to be a boolean",
+		`{"type": "Duration", "hints": ["kind"]}`: "expected hints to be an object",
+		`""`: "the go type may not be empty",
+		`12`: "expected a string or an object",
+	} {
+		var v any
+		require.NoError(t, json.Unmarshal([]byte(value), &v))
+		assert.EqualT(t, reason, invalidGoType(v))
+	}
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	stderrors "errors"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/jsonpointer"
+)
+
+// unsupportedSchemaKeywords lists JSON schema keywords which are not supported by Swagger 2.0 schemas,
+// with the alternative to use instead.
+var unsupportedSchemaKeywords = map[string]string{
+	"additionalItems":   "use items with a single schema",
+	"anyOf":             "use allOf, or a discriminator for polymorphism",
+	"dependencies":      "list the dependent properties in required",
+	"not":               "describe the allowed values with enum, pattern or other validations",
+	"nullable":          "use the x-nullable vendor extension",
+	"oneOf":             "use allOf, or a discriminator for polymorphism",
+	"patternProperties": "use additionalProperties",
+}
+
+// unsupportedSchemaKeywordKinds tells the kind of the schemas held by the unsupported keywords, which are not
+// walked by walkSpec.
+var unsupportedSchemaKeywordKinds = map[string]specKind{
+	"additionalItems":   specKindSchema,
+	"anyOf":             specKindSchemaList,
+	"dependencies":      specKindSchemas,
+	"not":               specKindSchema,
+	"oneOf":             specKindSchemaList,
+	"patternProperties": specKindSchemas,
+}
+
+// validateKeywordPlacement reports, with an explicit message, keywords which are not supported by Swagger 2.0
+// or which are used in the wrong place:
+//
+//   - JSON schema keywords not supported in Swagger 2.0 schemas (e.g. oneOf, anyOf, not, additionalItems)
+//   - required declared as a boolean on a schema property, instead of a list of property names on the parent object
+//   - $ref in response headers
+//
+// The swagger schema validation reports these as forbidden properties or invalid types: this check explains why
+// the keyword is not allowed and what to use instead. The forbidden properties explained by this check are
+// not reported by the swagger schema validation (see dropExplainedKeywords). The schemas nested under
+// unsupported keywords, e.g. the schemas of a oneOf, are checked as well.
+//
+// The check is carried out on the raw document, since unsupported keywords are not retained by the spec package.
+func (s *SpecValidator) validateKeywordPlacement(doc any) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	walkSpecLenient(doc, "", specKindRoot, func(pointer string, kind specKind, object map[string]any) bool {
+		switch kind {
+		case specKindSchema:
+			validateSchemaKeywords(pointer, object, res)
+
+		case specKindHeader:
+			if ref, isString := object["$ref"].(string); isString {
+				headers := strings.LastIndex(pointer, "/headers/")
+				msg := refNotAllowedInHeaderMsg(dottedPath(pointer[:headers]), jsonpointer.Unescape(pointer[headers+len("/headers/"):]), ref)
+				res.AddErrors(FindingAt(pointer, msg))
+			}
+		}
+
+		return true
+	})
+
+	return res
+}
+
+// explainsKeywords tells if the unsupported keywords are explained by the keyword-placement rule, rather than
+// reported as forbidden properties by the swagger schema validation.
+func (s *SpecValidator) explainsKeywords() bool {
+	return s.IsRuleEnabled("keyword-placement") && !s.ruleSettings().isOff("keyword-placement")
+}
+
+// validateSchemaKeywords checks the keywords of the schema at some JSON pointer. The schemas it contains are visited by walkSpecLenient.
+func validateSchemaKeywords(pointer string, schema map[string]any, res *Result) {
+	path := dottedPath(pointer)
+	for _, keyword := range sortedKeys(schema) {
+		if alternative, unsupported := unsupportedSchemaKeywords[keyword]; unsupported {
+			res.AddErrors(FindingAt(pointer, unsupportedKeywordMsg(path, keyword, alternative)))
+		}
+	}
+
+	if _, isBool := schema["required"].(bool); isBool {
+		res.AddErrors(FindingAt(pointer, misplacedKeywordMsg(path, "required",
+			"required must be declared on the parent object schema, as an array of property names"),
+		))
+	}
+}
+
+// walkSpecLenient walks a raw swagger document like walkSpec, and also visits the schemas nested under
+// the keywords which are not supported by Swagger 2.0 schemas, e.g. the schemas of a oneOf or of a not.
+func walkSpecLenient(node any, pointer string, kind specKind, visit func(pointer string, kind specKind, object map[string]any) bool) {
+	var lenient func(pointer string, kind specKind, object map[string]any) bool
+	lenient = func(pointer string, kind specKind, object map[string]any) bool {
+		if !visit(pointer, kind, object) {
+			return false
+		}
+
+		if kind == specKindSchema {
+			for _, keyword := range sortedKeys(object) {
+				if nested, unsupported := unsupportedSchemaKeywordKinds[keyword]; unsupported {
+					walkSpec(object[keyword], pointer+"/"+keyword, nested, lenient)
+				}
+			}
+		}
+
+		return true
+	}
+
+	walkSpec(node, pointer, kind, lenient)
+}
+
+// explainedKeywords returns the keywords explained by validateKeywordPlacement in a raw node of some kind,
+// as reported by the swagger schema validation, i.e. the dotted path of their object prefixed by the path
+// of the node (see keywordName).
+func explainedKeywords(node any, kind specKind, prefix string) map[string]struct{} {
+	explained := make(map[string]struct{})
+	walkSpecLenient(node, "", kind, func(pointer string, kind specKind, object map[string]any) bool {
+		path := strings.TrimPrefix(prefix+"."+dottedPath(pointer), ".")
+		switch kind {
+		case specKindSchema:
+			for keyword := range object {
+				if _, unsupported := unsupportedSchemaKeywords[keyword]; unsupported {
+					explained[keywordName(path, keyword)] = struct{}{}
+				}
+			}
+
+		case specKindHeader:
+			if _, isString := object["$ref"].(string); isString {
+				explained[keywordName(path, "$ref")] = struct{}{}
+			}
+		}
+
+		return true
+	})
+
+	return explained
+}
+
+// dropExplainedKeywords drops from a result of the swagger schema validation the forbidden properties which are
+// explained by validateKeywordPlacement, so the same keyword is not reported twice.
+func dropExplainedKeywords(res *Result, explained map[string]struct{}) {
+	if res == nil || len(explained) == 0 {
+		return
+	}
+
+	res.Errors = slices.DeleteFunc(res.Errors, func(err error) bool {
+		var validation *errors.Validation
+		if !stderrors.As(err, &validation) || validation.Code() != errors.UnallowedPropertyCode {
+			return false
+		}
+
+		keyword, _ := validation.Value.(string)
+		_, isExplained := explained[keywordName(validation.Name, keyword)]
+
+		return isExplained
+	})
+}
+
+// keywordName identifies a keyword by the dotted path of its schema, without the indices of arrays:
+// the swagger schema validation does not report the index of parameters.
+func keywordName(path, keyword string) string {
+	tokens := strings.Split(path, ".")
+	tokens = slices.DeleteFunc(tokens, func(token string) bool {
+		_, err := strconv.Atoi(token)
+		return err == nil
+	})
+
+	return strings.Join(append(tokens, keyword), ".")
+}
+
+func asObject(value any) map[string]any {
+	obj, _ := value.(map[string]any)
+
+	return obj
+}
+
+func asArray(value any) []any {
+	arr, _ := value.([]any)
+
+	return arr
+}
+
+// sortedKeys returns the keys of a JSON object, sorted to report messages in a stable order.
+func sortedKeys(value any) []string {
+	obj := asObject(value)
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_ValidateKeywordPlacement(t *testing.T) {
+	t.Run("should explain unsupported and misplaced keywords", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-keywords.yaml")
+		assert.FalseT(t, res.IsValid())
+
+		errs := verifiedTestErrors(res)
+		for _, msg := range []string{
+			`in "definitions.pet.properties.kind", keyword "oneOf" is not supported by Swagger 2.0 schemas: use allOf, or a discriminator for polymorphism`,
+			`in "definitions.pet.properties.tags.items", keyword "nullable" is not supported by Swagger 2.0 schemas: use the x-nullable vendor extension`,
+			`in "definitions.pet.properties.kind.oneOf.0", keyword "nullable" is not supported by Swagger 2.0 schemas: use the x-nullable vendor extension`,
+			`in "paths./pets.post.parameters.0.schema", keyword "not" is not supported by Swagger 2.0 schemas: describe the allowed values with enum, pattern or other validations`,
+			`in "paths./pets.post.parameters.0.schema.not", keyword "anyOf" is not supported by Swagger 2.0 schemas: use allOf, or a discriminator for polymorphism`,
+			`in "paths./pets.post.responses.200.schema", keyword "additionalItems" is not supported by Swagger 2.0 schemas: use items with a single schema`,
+			`in "paths./pets.post.responses.200": $ref are not allowed in headers. In context for header "X-Rate-Limit", one may not use $ref="#/definitions/limit"`,
+		} {
+			assert.SliceContainsT(t, errs, msg)
+		}
+
+		// explained keywords are not reported as forbidden properties
+		for _, msg := range errs {
+			assert.FalseT(t, strings.HasSuffix(msg, "is a forbidden property"), msg)
+		}
+	})
+
+	t.Run("should report forbidden properties when the keyword placement is not checked", func(t *testing.T) {
+		res, _ := loadJSONAndValidate(t, `{
+			"swagger": "2.0",
+			"info": {"title": "t", "version": "1"},
+			"paths": {},
+			"definitions": {"pet": {"type": "object", "not": {"type": "string"}}}
+		}`, func(o *Opts) {
+			o.Rules = &RulesConfig{Rules: map[string]RuleConfig{"keyword-placement": {Severity: SeverityOff}}}
+		})
+
+		assert.SliceContainsT(t, verifiedTestErrors(res), `definitions.pet.not in body is a forbidden property`)
+	})
+
+	t.Run("should not report vendor extensions or properties named after keywords", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-keywords-good.yaml")
+		assert.TrueT(t, res.IsValid())
+	})
+}
+
+func TestSpec_ValidateMisplacedRequired(t *testing.T) {
+	// a boolean required on a property is rejected when loading the spec: the message is available to callers
+	// validating a raw document
+	var doc any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"definitions": {
+			"pet": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "required": true},
+					"tags": {"type": "array", "items": {"type": "object", "properties": {"label": {"required": false}}}}
+				}
+			}
+		}
+	}`), &doc))
+
+	res := new(SpecValidator).validateKeywordPlacement(doc)
+	assert.Equal(t, []string{
+		`in "definitions.pet.properties.name", keyword "required" is misplaced: required must be declared on the parent object schema, as an array of property names`,
+		`in "definitions.pet.properties.tags.items.properties.label", keyword "required" is misplaced: required must be declared on the parent object schema, as an array of property names`,
+	}, verifiedTestErrors(res))
+}
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"mime"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+const (
+	mediaTypeMultipartForm  = "multipart/form-data"
+	mediaTypeURLEncodedForm = "application/x-www-form-urlencoded"
+	mediaTypeJSON           = "application/json"
+	mediaTypeTextPlain      = "text/plain"
+)
+
+type mediaTypeError string
+
+func (e mediaTypeError) Error() string {
+	return string(e)
+}
+
+// errMissingSubtype indicates a media type without a subtype.
+const errMissingSubtype mediaTypeError = "expected a media type of the form type/subtype"
+
+// validateConsumesProduces checks the rules tying parameters and responses to the media types
+// declared in consumes and produces:
+//
+//   - media types in consumes and produces, at the global and operation level, must be syntactically valid (error)
+//   - a parameter of type file must be declared in formData (error)
+//   - type file is not allowed for items, response headers or body parameter schemas (error)
+//   - an operation with a file parameter must consume multipart/form-data or application/x-www-form-urlencoded (error)
+//   - an operation with formData parameters should consume one of these media types (warning)
+//   - a response returning a file should produce some media type suitable for binary content (warning)
+//
+// The effective consumes and produces of an operation are the ones declared at the operation level,
+// or the global ones when the operation doesn't declare any.
+func (s *SpecValidator) validateConsumesProduces() *Result {
+	res := pools.poolOfResults.BorrowResult()
+	sw := s.spec.Spec()
+
+	res.Merge(validateMediaTypes(sw.Consumes, "global consumes").locate("/consumes"))
+	res.Merge(validateMediaTypes(sw.Produces, "global produces").locate("/produces"))
+
+	analyzer := s.expandedAnalyzer()
+	for method, pi := range analyzer.Operations() {
+		for path, op := range pi {
+			pointer := operationPointer(method, path)
+			res.Merge(validateMediaTypes(op.Consumes, fmt.Sprintf("consumes in operation %q", op.ID)).locate(pointer + "/consumes"))
+			res.Merge(validateMediaTypes(op.Produces, fmt.Sprintf("produces in operation %q", op.ID)).locate(pointer + "/produces"))
+
+			var fileParams []string
+			var hasForm bool
+			for _, param := range paramHelp.safeExpandedParamsFor(path, method, op.ID, res, s) {
+				if param.In == swaggerFormData {
+					hasForm = true
+				}
+
+				if param.Type == fileType {
+					if param.In != swaggerFormData {
+						res.AddErrors(FindingAt(s.paramPointer(method, path, &param), fileParamNotInFormDataMsg(op.ID, param.Name, param.In)))
+					} else {
+						fileParams = append(fileParams, param.Name)
+					}
+				}
+
+				if param.In == swaggerBody && param.Schema != nil && param.Schema.Type.Contains(fileType) {
+					msg := fileTypeNotAllowedMsg(fmt.Sprintf("schema for body param %q", param.Name), op.ID)
+					res.AddErrors(FindingAt(s.paramPointer(method, path, &param), msg))
+				}
+
+				for items := param.Items; items != nil; items = items.Items {
+					if items.Type == fileType {
+						msg := fileTypeNotAllowedMsg(fmt.Sprintf("items of param %q", param.Name), op.ID)
+						res.AddErrors(FindingAt(s.paramPointer(method, path, &param), msg))
+						break
+					}
+				}
+			}
+
+			consumes := analyzer.ConsumesFor(op)
+			switch {
+			case len(fileParams) > 0 && !consumesForm(consumes):
+				sort.Strings(fileParams)
+				res.AddErrors(FindingAt(pointer, fileParamRequiresFormConsumesMsg(op.ID, fileParams)))
+			case hasForm && !consumesForm(consumes):
+				res.AddWarnings(FindingAt(pointer, formDataRequiresFormConsumesMsg(op.ID)))
+			}
+
+			res.Merge(s.validateFileResponses(method, path, op, analyzer.ProducesFor(op)))
+		}
+	}
+
+	return res
+}
+
+// validateFileResponses checks response headers and response schemas declaring type file.
+func (s *SpecValidator) validateFileResponses(method, path string, op *spec.Operation, produces []string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	if op.Responses == nil {
+		return res
+	}
+
+	check := func(resp *spec.Response, responseType string, code int) {
+		response, red := responseHelp.expandResponseRef(resp, path, s)
+		if !red.IsValid() {
+			// unresolved responses are reported elsewhere
+			return
+		}
+		responseName, _ := responseHelp.responseMsgVariants(responseType, code)
+		pointer := responsePointer(method, path, responseType, code)
+
+		for hn, h := range response.Headers {
+			if h.Type == fileType {
+				res.AddErrors(FindingAt(pointer, fileTypeNotAllowedMsg(fmt.Sprintf("header %q in %s", hn, responseName), op.ID)))
+			}
+			for items := h.Items; items != nil; items = items.Items {
+				if items.Type == fileType {
+					msg := fileTypeNotAllowedMsg(fmt.Sprintf("items of header %q in %s", hn, responseName), op.ID)
+					res.AddErrors(FindingAt(pointer, msg))
+					break
+				}
+			}
+		}
+
+		if response.Schema != nil && response.Schema.Type.Contains(fileType) && !producesBinary(produces) {
+			res.AddWarnings(FindingAt(pointer, fileResponseProducesMsg(op.ID, responseName, produces)))
+		}
+	}
+
+	if op.Responses.Default != nil {
+		check(op.Responses.Default, jsonDefault, 0)
+	}
+	for code, resp := range op.Responses.StatusCodeResponses {
+		check(&resp, "response", code) //#nosec
+	}
+
+	return res
+}
+
+// validateMediaTypes reports every media type which is not a syntactically valid "type/subtype" string,
+// optionally followed by parameters.
+func validateMediaTypes(mediaTypes []string, in string) *Result {
+	if len(mediaTypes) == 0 {
+		return nil
+	}
+
+	res := pools.poolOfResults.BorrowResult()
+	for _, mt := range mediaTypes {
+		if err := checkMediaType(mt); err != nil {
+			res.AddErrors(invalidMediaTypeMsg(in, mt, err))
+		}
+	}
+
+	return res
+}
+
+// checkMediaType verifies that a media type is of the form "type/subtype[;param=value]*".
+//
+// mime.ParseMediaType alone is too lenient, as it accepts media types without a subtype.
+func checkMediaType(mt string) error {
+	parsed, _, err := mime.ParseMediaType(mt)
+	if err != nil {
+		return err
+	}
+
+	typ, subtype, found := strings.Cut(parsed, "/")
+	if !found || typ == "" || subtype == "" || strings.Contains(subtype, "/") {
+		return errMissingSubtype
+	}
+
+	return nil
+}
+
+// normalizedMediaType returns the media type without parameters, lower-cased.
+//
+// Media types which cannot be parsed are returned trimmed and lower-cased.
+func normalizedMediaType(mt string) string {
+	if parsed, _, err := mime.ParseMediaType(mt); err == nil {
+		return parsed
+	}
+
+	base, _, _ := strings.Cut(mt, ";")
+
+	return strings.ToLower(strings.TrimSpace(base))
+}
+
+// isJSONMediaType tells if a media type is application/json or uses the +json structured syntax suffix
+// (e.g. application/problem+json).
+func isJSONMediaType(mt string) bool {
+	normalized := normalizedMediaType(mt)
+
+	return normalized == mediaTypeJSON || strings.HasSuffix(normalized, "+json")
+}
+
+// isYAMLMediaType tells if a media type is one of the common YAML media types or uses the +yaml structured syntax suffix.
+func isYAMLMediaType(mt string) bool {
+	normalized := normalizedMediaType(mt)
+
+	switch normalized {
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return true
+	default:
+		return strings.HasSuffix(normalized, "+yaml")
+	}
+}
+
+// isXMLMediaType tells if a media type is application/xml, text/xml or uses the +xml structured syntax suffix
+// (e.g. application/atom+xml).
+func isXMLMediaType(mt string) bool {
+	normalized := normalizedMediaType(mt)
+
+	return normalized == "application/xml" || normalized == "text/xml" || strings.HasSuffix(normalized, "+xml")
+}
+
+// consumesForm tells if some media type suitable for formData parameters is consumed.
+func consumesForm(consumes []string) bool {
+	for _, mt := range consumes {
+		switch normalizedMediaType(mt) {
+		case mediaTypeMultipartForm, mediaTypeURLEncodedForm:
+			return true
+		}
+	}
+
+	return false
+}
+
+// producesBinary tells if some media type suitable for a file response is produced.
+//
+// Any media type which is not JSON may convey the binary content of a file.
+func producesBinary(produces []string) bool {
+	for _, mt := range produces {
+		if !isJSONMediaType(mt) {
+			return true
+		}
+	}
+
+	return false
+}
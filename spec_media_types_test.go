@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+)
+
+func TestCheckMediaType(t *testing.T) {
+	for _, mt := range []string{
+		"application/json",
+		"application/json; charset=utf-8",
+		"application/problem+json",
+		"multipart/form-data",
+		"text/*",
+		"*/*",
+	} {
+		assert.NoErrorf(t, checkMediaType(mt), "expected %q to be a valid media type", mt)
+	}
+
+	for _, mt := range []string{
+		"",
+		"json",
+		"application/",
+		"/json",
+		"application/json/extra",
+		"application json",
+		"application/json; charset",
+	} {
+		assert.Errorf(t, checkMediaType(mt), "expected %q to be an invalid media type", mt)
+	}
+}
+
+func TestMediaTypeHelpers(t *testing.T) {
+	assert.TrueT(t, isJSONMediaType("application/json"))
+	assert.TrueT(t, isJSONMediaType("Application/JSON; charset=utf-8"))
+	assert.TrueT(t, isJSONMediaType("application/hal+json"))
+	assert.FalseT(t, isJSONMediaType("application/xml"))
+	assert.FalseT(t, isJSONMediaType("application/jsonp"))
+
+	assert.TrueT(t, consumesForm([]string{"application/json", "multipart/form-data; boundary=x"}))
+	assert.TrueT(t, consumesForm([]string{"application/x-www-form-urlencoded"}))
+	assert.FalseT(t, consumesForm([]string{"application/json"}))
+	assert.FalseT(t, consumesForm(nil))
+
+	assert.TrueT(t, producesBinary([]string{"application/json", "application/octet-stream"}))
+	assert.FalseT(t, producesBinary([]string{"application/json", "application/problem+json"}))
+	assert.FalseT(t, producesBinary(nil))
+}
+
+func TestSpec_ValidateConsumesProduces(t *testing.T) {
+	t.Run("should accept a file upload with multipart/form-data", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-media-types-good.yaml")
+		assert.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Errors)
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should report invalid media types and unsuitable consumes or produces", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-media-types.yaml")
+		errs := verifiedTestErrors(res)
+		assert.Len(t, errs, 3)
+		assert.SliceContainsT(t, errs, `global consumes has an invalid media type "json": expected a media type of the form type/subtype`)
+		assert.SliceContainsT(t, errs, `produces in operation "getA" has an invalid media type "application/json; charset": mime: invalid media parameter`)
+		assert.SliceContainsT(t, errs,
+			`operation "upload" has file parameters [file] but does not consume multipart/form-data or application/x-www-form-urlencoded`,
+		)
+
+		warnings := verifiedTestWarnings(res)
+		assert.Len(t, warnings, 2, "the formData warning on upload is superseded by the file parameter error")
+		assert.SliceContainsT(t, warnings,
+			`operation "postForm" has formData parameters but does not consume multipart/form-data or application/x-www-form-urlencoded`,
+		)
+		assert.SliceContainsT(t, warnings,
+			`in operation "download", default response returns a file, but the operation produces no media type suitable for binary content: [application/json]`,
+		)
+	})
+
+	t.Run("should report type file in other locations", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-file-type-locations.yaml")
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs, `in operation "postA", param "q" is of type file: file parameters must be declared in formData, not in query`)
+		assert.SliceContainsT(t, errs,
+			`items of param "list" in operation "postA" cannot be of type file: only formData parameters and response schemas may declare type file`,
+		)
+		assert.SliceContainsT(t, errs,
+			`schema for body param "body" in operation "postA" cannot be of type file: only formData parameters and response schemas may declare type file`,
+		)
+		assert.SliceContainsT(t, errs,
+			`header "X-File" in response 200 in operation "postA" cannot be of type file: only formData parameters and response schemas may declare type file`,
+		)
+	})
+}
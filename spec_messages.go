@@ -5,12 +5,17 @@ package validate
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/go-openapi/errors"
 )
 
 // Error messages related to spec validation and returned as results.
 const (
+	// AllowEmptyValueNotAllowedError indicates a parameter declaring allowEmptyValue, which is only valid for
+	// query and formData parameters.
+	AllowEmptyValueNotAllowedError = "in operation %q, param %q in %s may not declare allowEmptyValue: this is only valid for query and formData params"
+
 	// ArrayRequiresItemsError ...
 	ArrayRequiresItemsError = "%s for %q is a collection without an element type (array requires items definition)"
 
@@ -23,12 +28,18 @@ const (
 	// BothFormDataAndBodyError indicates that an operation specifies both a body and a formData parameter, which is forbidden.
 	BothFormDataAndBodyError = "operation %q has both formData and body parameters. Only one such In: type may be used for a given operation"
 
+	// BrokenReferenceError indicates a $ref which cannot be resolved, with the JSON pointer of the referrer and the reason.
+	BrokenReferenceError = "in %s, $ref %s cannot be resolved: %s"
+
 	// CannotResolveReferenceError when a $ref could not be resolved.
 	CannotResolveReferenceError = "could not resolve reference in %s to $ref %s: %v"
 
 	// CircularAncestryDefinitionError ...
 	CircularAncestryDefinitionError = "definition %q has circular ancestry: %v"
 
+	// CircularReferenceChainError indicates a chain of $ref which loops without ever reaching an actual object.
+	CircularReferenceChainError = "in %s, circular chain of $ref without any actual object: %s"
+
 	// DefaultValueDoesNotValidateError results from an invalid default value provided.
 	DefaultValueDoesNotValidateError = "default value for %s in %s does not validate its schema"
 
@@ -44,12 +55,33 @@ const (
 	// DefaultValueInDoesNotValidateError ...
 	DefaultValueInDoesNotValidateError = "in operation %q, default value in %s does not validate its schema"
 
+	// DuplicateEnumValuesError indicates an enum with duplicate members. Enum members must be unique.
+	DuplicateEnumValuesError = "enum for %s in %s contains duplicate values: %v"
+
 	// DuplicateParamNameError ...
 	DuplicateParamNameError = "duplicate parameter name %q for %q in operation %q"
 
+	// DuplicateResponseHeaderError indicates response headers which differ only by case. HTTP header names are case-insensitive.
+	DuplicateResponseHeaderError = "in operation %q, %s declares headers which differ only by case: %v"
+
 	// DuplicatePropertiesError ...
 	DuplicatePropertiesError = "definition %q contains duplicate properties: %v"
 
+	// EnumValueDoesNotValidateError results from an invalid enum value provided.
+	EnumValueDoesNotValidateError = "enum values for %s in %s do not validate their schema"
+
+	// EnumValueItemsDoesNotValidateError results from an invalid enum value provided for Items.
+	EnumValueItemsDoesNotValidateError = "enum values for %s.items in %s do not validate their schema"
+
+	// EnumValueHeaderDoesNotValidateError results from an invalid enum value provided in header.
+	EnumValueHeaderDoesNotValidateError = "in operation %q, enum values in header %s for %s do not validate their schema"
+
+	// EnumValueHeaderItemsDoesNotValidateError results from an invalid enum value provided in header.items.
+	EnumValueHeaderItemsDoesNotValidateError = "in operation %q, enum values in header.items %s for %s do not validate their schema"
+
+	// EnumValueInDoesNotValidateError results from an invalid enum value provided in a response schema.
+	EnumValueInDoesNotValidateError = "in operation %q, enum values in %s do not validate their schema"
+
 	// ExampleValueDoesNotValidateError results from an invalid example value provided.
 	ExampleValueDoesNotValidateError = "example value for %s in %s does not validate its schema"
 
@@ -68,12 +100,44 @@ const (
 	// EmptyPathParameterError means that a path parameter was found empty (e.g. "{}").
 	EmptyPathParameterError = "%q contains an empty path parameter"
 
+	// ExtensionDoesNotValidateError indicates a vendor extension which does not validate the schema registered for it.
+	ExtensionDoesNotValidateError = "in %s, extension %s does not validate its schema"
+
+	// ExtensionNotAllowedError indicates a vendor extension declared on a kind of object it is not registered for.
+	ExtensionNotAllowedError = "in %s, extension %s is not allowed on a %s: expected on %s"
+
+	// FileParamNotInFormDataError indicates a parameter of type file declared in another location than formData.
+	FileParamNotInFormDataError = "in operation %q, param %q is of type file: file parameters must be declared in formData, not in %s"
+
+	// FileParamRequiresFormConsumesError indicates an operation with a file parameter which does not consume
+	// multipart/form-data or application/x-www-form-urlencoded.
+	FileParamRequiresFormConsumesError = "operation %q has file parameters %v but does not consume multipart/form-data or application/x-www-form-urlencoded"
+
+	// FileTypeNotAllowedError indicates that type file is used where Swagger does not allow it.
+	FileTypeNotAllowedError = "%s in operation %q cannot be of type file: only formData parameters and response schemas may declare type file"
+
+	// GoNameCollisionError indicates names which map to the same go identifier. This is reported with the GoSwaggerMode option.
+	GoNameCollisionError = "%s %s map to the same go identifier %q"
+
+	// InvalidBasePathError indicates a basePath which does not begin with a slash, or which contains path templating.
+	InvalidBasePathError = "basePath %q is invalid: %s"
+
 	// InvalidDocumentError states that spec validation only processes spec.Document objects.
 	InvalidDocumentError = "spec validator can only validate spec.Document objects"
 
+	// InvalidGoExtensionError indicates an invalid value for a vendor extension interpreted by go-swagger.
+	// This is reported with the GoSwaggerMode option.
+	InvalidGoExtensionError = "in %s, %s is invalid: %s"
+
+	// InvalidHostError indicates a host which is not a host name or an IP address, with an optional port.
+	InvalidHostError = "host %q is invalid: %s"
+
 	// InvalidItemsPatternError indicates an Items definition with invalid pattern.
 	InvalidItemsPatternError = "%s for %q has invalid items pattern: %q"
 
+	// InvalidMediaTypeError indicates a media type in consumes or produces which is not syntactically valid.
+	InvalidMediaTypeError = "%s has an invalid media type %q: %v"
+
 	// InvalidParameterDefinitionError indicates an error detected on a parameter definition.
 	InvalidParameterDefinitionError = "invalid definition for parameter %s in %s in operation %q"
 
@@ -93,13 +157,22 @@ const (
 	// InvalidPatternInParamError ...
 	InvalidPatternInParamError = "operation %q has invalid pattern in param %q: %q"
 
+	// InvalidSchemeError indicates a scheme which is not supported by Swagger 2.0.
+	InvalidSchemeError = "in %s, scheme %q is invalid: expected one of %s"
+
 	// InvalidReferenceError indicates that a $ref property could not be resolved.
 	InvalidReferenceError = "invalid ref %q"
 
+	// InvalidResponseCodeError indicates a response code which is neither an HTTP status code nor default.
+	InvalidResponseCodeError = "in operation %q, %q is not a valid response code: expected an HTTP status code from 100 to 599, or default"
+
 	// InvalidResponseDefinitionAsSchemaError indicates an error detected on a response definition, which was mistaken with a schema definition.
 	// Most likely, this situation is encountered whenever a $ref has been added as a sibling of the response definition.
 	InvalidResponseDefinitionAsSchemaError = "invalid definition as Schema for response %s in %s"
 
+	// MisplacedKeywordError indicates a keyword used in a context where it is not allowed.
+	MisplacedKeywordError = "in %q, keyword %q is misplaced: %s"
+
 	// MultipleBodyParamError indicates that an operation specifies multiple parameter with in: body.
 	MultipleBodyParamError = "operation %q has more than 1 body param: %v"
 
@@ -124,50 +197,224 @@ const (
 	// PathParamNotUniqueError ...
 	PathParamNotUniqueError = "params in path %q must be unique: %q conflicts with %q"
 
+	// PathParamObjectTypeError indicates a path parameter of type object, which cannot be serialized in a path.
+	PathParamObjectTypeError = "in operation %q, path param %q may not be of type object"
+
 	// PathParamRequiredError ...
 	PathParamRequiredError = "in operation %q,path param %q must be declared as required"
 
 	// RefNotAllowedInHeaderError indicates a $ref was found in a header definition, which is not allowed by Swagger.
-	RefNotAllowedInHeaderError = "IMPORTANT!in %q: $ref are not allowed in headers. In context for header %q%s"
+	RefNotAllowedInHeaderError = "in %q: $ref are not allowed in headers. In context for header %q, one may not use $ref=%q"
 
 	// RequiredButNotDefinedError ...
 	RequiredButNotDefinedError = "%q is present in required but not defined as property in definition %q"
 
+	// RequiredNotDefinedInCompositionError indicates a required property which is neither defined by a definition, nor by any
+	// of the schemas it is composed of with allOf, anyOf or oneOf.
+	RequiredNotDefinedInCompositionError = "%q is present in required but not defined as property in definition %q, nor in any of its allOf, anyOf or oneOf schemas"
+
 	// SomeParametersBrokenError indicates that some parameters could not be resolved, which might result in partial checks to be carried on.
 	SomeParametersBrokenError = "some parameters definitions are broken in %q.%s. Cannot carry on full checks on parameters for operation %s"
 
+	// UnsatisfiableRequiredCycleError indicates definitions which require, directly or not, an instance of themselves,
+	// through required properties, array items with minItems >= 1 or allOf: no finite document can satisfy them.
+	UnsatisfiableRequiredCycleError = "definition %q cannot be satisfied by any finite document: required cycle %s"
+
+	// UnknownExtensionError indicates a vendor extension which is not registered. This is reported with the StrictExtensions option.
+	UnknownExtensionError = "in %s, extension %s is not registered"
+
+	// UnsupportedKeywordError indicates a JSON schema keyword which is not supported by Swagger 2.0.
+	UnsupportedKeywordError = "in %q, keyword %q is not supported by Swagger 2.0 schemas: %s"
+
 	// UnresolvedReferencesError indicates that at least one $ref could not be resolved.
 	UnresolvedReferencesError = "some references could not be resolved in spec. First found: %v"
 )
 
 // Warning messages related to spec validation and returned as results.
 const (
+	// BodyParamWithoutBodySemanticsWarning indicates a body parameter for a method which request body has no defined semantics.
+	BodyParamWithoutBodySemanticsWarning = "in operation %q, body param %q is declared for method %s, which request body has no defined semantics"
+
 	// ExamplesWithoutSchemaWarning indicates that examples are provided for a response,but not schema to validate the example against.
 	ExamplesWithoutSchemaWarning = "Examples provided without schema in operation %q, %s"
 
+	// ExampleMediaTypeParseWarning indicates a response example which cannot be parsed according to its media type.
+	ExampleMediaTypeParseWarning = "in operation %q, %s example for media type %s cannot be parsed: %v"
+
 	// ExamplesMimeNotSupportedWarning indicates that examples are provided with a mime type different than application/json, which
 	// the validator dos not support yetl.
+	//
+	// It is no longer reported: see ExamplesMediaTypeNotSupportedWarning.
 	ExamplesMimeNotSupportedWarning = "No validation attempt for examples for media types other than application/json, in operation %q, %s"
 
+	// ExamplesMediaTypeNotSupportedWarning indicates that examples are provided with a media type which the validator does not support:
+	// only JSON, YAML, XML and plain text (for string schemas) examples are validated.
+	ExamplesMediaTypeNotSupportedWarning = "No validation attempt for examples for media type %s, in operation %q, %s"
+
+	// FileResponseProducesWarning indicates a response returning a file for an operation which only produces JSON media types.
+	FileResponseProducesWarning = "in operation %q, %s returns a file, but the operation produces no media type suitable for binary content: %v"
+
+	// FormDataRequiresFormConsumesWarning indicates an operation with formData parameters which does not consume
+	// multipart/form-data or application/x-www-form-urlencoded.
+	FormDataRequiresFormConsumesWarning = "operation %q has formData parameters but does not consume multipart/form-data or application/x-www-form-urlencoded"
+
+	// HeadResponseHasSchemaWarning indicates a response to a HEAD operation declaring a schema. Such responses have no body.
+	HeadResponseHasSchemaWarning = "in operation %q, %s declares a schema, but responses to HEAD requests have no body"
+
+	// DuplicateDefinitionsWarning indicates definitions which are structurally identical.
+	// This is reported with the DuplicateDefinitions option.
+	DuplicateDefinitionsWarning = "definitions %s are structurally identical: consider keeping only %q"
+
+	// DuplicateTagWarning indicates a tag declared several times in the top-level tags.
+	// This is reported with the UniqueTags convention.
+	DuplicateTagWarning = "tag %q is declared %d times in the top-level tags"
+
+	// GoReservedKeywordWarning indicates a name which maps to a reserved go keyword. This is reported with the GoSwaggerMode option.
+	GoReservedKeywordWarning = "in %s, %q maps to the reserved go keyword %q"
+
+	// HostWithPathWarning indicates a host which contains a path, which should be declared in basePath instead.
+	HostWithPathWarning = "host %q contains the path %q, which should be declared in basePath"
+
+	// NoSuccessResponseWarning indicates an operation without any 2xx or 3xx response, nor a default response.
+	NoSuccessResponseWarning = "operation %q has no success response: expected at least one 2xx or 3xx response, or a default response"
+
+	// InvalidSuppressionWarning indicates that x-validate-ignore is not a list of rule IDs.
+	InvalidSuppressionWarning = "%s in %s should be a rule ID or a list of rule IDs"
+
+	// InvalidExternalDocsURLWarning indicates an externalDocs url which is not an absolute URL.
+	// This is reported with the DocumentationLint option.
+	InvalidExternalDocsURLWarning = "externalDocs in %s have an invalid url %q"
+
+	// NamingConventionWarning indicates an operationId or a definition which does not follow the configured naming convention.
+	NamingConventionWarning = "%s %q does not follow the %s naming convention"
+
+	// OnlyInsecureSchemesWarning indicates schemes which only list insecure schemes (http or ws), which transfer
+	// data and credentials in clear text.
+	OnlyInsecureSchemesWarning = "%s only list insecure schemes (%s): consider https or wss"
+
+	// PathSegmentConventionWarning indicates a path segment which does not follow the configured naming convention.
+	PathSegmentConventionWarning = "path %q has a segment %q which does not follow the %s naming convention"
+
+	// PathParamArrayWithoutCollectionFormatWarning indicates a path parameter of type array without an explicit collectionFormat.
+	PathParamArrayWithoutCollectionFormatWarning = "in operation %q, path param %q is an array without collectionFormat: csv is assumed"
+
+	// PathParamDefaultWarning indicates a path parameter with a default value. Path parameters are always required:
+	// the default value is never used.
+	PathParamDefaultWarning = "in operation %q, path param %q has a default value, which is never used since path params are always required"
+
 	// PathParamGarbledWarning ...
 	PathParamGarbledWarning = "in path %q, param %q contains {,} or white space. Albeit not stricly illegal, this is probably no what you want"
 
 	// ParamValidationTypeMismatch indicates that parameter has validation which does not match its type.
 	ParamValidationTypeMismatch = "validation keywords of parameter %q in path %q don't match its type %s"
 
+	// PathCaseOnlyWarning indicates two paths which differ only by case. Some routers match paths case-insensitively.
+	//
+	// This is reported as an error when the StrictPathAmbiguity option is enabled.
+	PathCaseOnlyWarning = "path %s differs from path %s only by case"
+
+	// PathParamPatternMatchesLiteralWarning indicates a literal path segment which matches the pattern of a path parameter
+	// in another path, for the same method.
+	//
+	// This is reported as an error when the StrictPathAmbiguity option is enabled.
+	PathParamPatternMatchesLiteralWarning = "path %s is ambiguous with path %s for method %s: literal segment %q matches the pattern %q of path param %q"
+
+	// PathParamShadowsLiteralWarning indicates a literal path segment which may be captured by a path parameter
+	// in another path, for the same method.
+	//
+	// This is reported as an error when the StrictPathAmbiguity option is enabled.
+	PathParamShadowsLiteralWarning = "path %s is ambiguous with path %s for method %s: literal segment %q may be captured by segment %q"
+
 	// PathStrippedParamGarbledWarning ...
 	PathStrippedParamGarbledWarning = "path stripped from path parameters %s contains {,} or white space. This is probably no what you want."
 
+	// PathTrailingSlashWarning indicates two paths which differ only by a trailing slash. Some routers don't tell them apart.
+	//
+	// This is reported as an error when the StrictPathAmbiguity option is enabled.
+	PathTrailingSlashWarning = "path %s differs from path %s only by a trailing slash"
+
+	// ReservedHeaderParamWarning indicates a header parameter which conflicts with the consumes, produces or security
+	// declarations of an operation, e.g. Content-Type, Accept or Authorization.
+	ReservedHeaderParamWarning = "in operation %q, header param %q should not be declared as a param: use %s instead"
+
 	// ReadOnlyAndRequiredWarning ...
 	ReadOnlyAndRequiredWarning = "Required property %s in %q should not be marked as both required and readOnly"
 
 	// RefShouldNotHaveSiblingsWarning indicates that a $ref was found with a sibling definition. This results in the $ref taking over its siblings,
 	// which is most likely not wanted.
+	//
+	// It is no longer reported: see RefSiblingsIgnoredWarning.
 	RefShouldNotHaveSiblingsWarning = "$ref property should have no sibling in %q.%s"
 
+	// PropertyNamingConventionWarning indicates a property which does not follow the configured naming convention.
+	PropertyNamingConventionWarning = "property %q in %s does not follow the %s naming convention"
+
+	// RefSiblingsIgnoredWarning indicates keywords declared next to a $ref in a schema, a parameter or a response.
+	// These are ignored: the $ref takes over its siblings.
+	//
+	// This is reported as an error when the StrictRefSiblings option is enabled.
+	RefSiblingsIgnoredWarning = "in %s, keywords next to $ref %s are ignored: %s"
+
+	// ResponseWithoutBodyHasSchemaWarning indicates a response declaring a schema with a status code which conveys no body (204, 304).
+	ResponseWithoutBodyHasSchemaWarning = "in operation %q, %s declares a schema, but this status code conveys no response body"
+
 	// RequiredHasDefaultWarning indicates that a required parameter property should not have a default.
 	RequiredHasDefaultWarning = "%s in %s has a default value and is required as parameter"
 
+	// SecurityAPIKeyInQueryWarning indicates an API key sent in the query string. This is reported with the SecurityLint option.
+	SecurityAPIKeyInQueryWarning = "security definition %q sends an API key in the query string, where it may be logged: prefer a header"
+
+	// SecurityNoRequirementWarning indicates an operation without any security requirement. This is reported with the SecurityLint option.
+	SecurityNoRequirementWarning = "operation %q has no security requirement"
+
+	// SecurityOAuth2ImplicitWarning indicates the OAuth2 implicit flow. This is reported with the SecurityLint option.
+	SecurityOAuth2ImplicitWarning = "security definition %q uses the OAuth2 implicit flow, which exposes tokens: prefer the accessCode flow"
+
+	// SecurityPasswordInResponseWarning indicates a response returning a password field. This is reported with the SecurityLint option.
+	SecurityPasswordInResponseWarning = "in operation %q, %s returns the password field %s"
+
+	// SecurityReadOnlyInRequestWarning indicates a request body accepting a readOnly field, which clients should not set.
+	// This is reported with the SecurityLint option.
+	SecurityReadOnlyInRequestWarning = "in operation %q, body param %q accepts the readOnly field %s"
+
+	// StaleSuppressionWarning indicates that x-validate-ignore suppresses a rule which reports nothing on this object.
+	StaleSuppressionWarning = "%s in %s does not suppress any finding of rule %q"
+
+	// UndeclaredTagWarning indicates an operation using a tag which is not declared in the top-level tags.
+	// This is reported with the DeclaredTags convention.
+	UndeclaredTagWarning = "operation %q uses the tag %q, which is not declared in the top-level tags"
+
+	// UndocumentedDefinitionWarning indicates a definition without title or description.
+	// This is reported with the DocumentationLint option.
+	UndocumentedDefinitionWarning = "definition %q has no %s"
+
+	// UndocumentedInfoWarning indicates an info object without contact or license.
+	// This is reported with the DocumentationLint option.
+	UndocumentedInfoWarning = "info has no %s"
+
+	// UndocumentedOperationWarning indicates an operation without summary or description.
+	// This is reported with the DocumentationLint option.
+	UndocumentedOperationWarning = "operation %q has no %s"
+
+	// UndocumentedParamWarning indicates a parameter without description.
+	// This is reported with the DocumentationLint option.
+	UndocumentedParamWarning = "in operation %q, param %q in %s has no description"
+
+	// UndocumentedResponseWarning indicates a response without description.
+	// This is reported with the DocumentationLint option.
+	UndocumentedResponseWarning = "in operation %q, %s has no description"
+
+	// UnboundedInRequestWarning indicates a request parameter or body which does not bound the size of the data it accepts.
+	// This is reported with the UnboundedResourceLint option.
+	UnboundedInRequestWarning = "in operation %q, %s in request %s is unbounded: %s"
+
+	// UnboundedInResponseWarning indicates a response header or body which does not bound the size of the data it returns.
+	// This is reported with the UnboundedResourceLint option.
+	UnboundedInResponseWarning = "in operation %q, %s in %s is unbounded: %s"
+
+	// UnknownSuppressedRuleWarning indicates that x-validate-ignore refers to a rule which is not registered.
+	UnknownSuppressedRuleWarning = "%s in %s suppresses the unknown rule %q"
+
 	// UnusedDefinitionWarning ...
 	UnusedDefinitionWarning = "definition %q is not used anywhere"
 
@@ -177,6 +424,9 @@ const (
 	// UnusedResponseWarning ...
 	UnusedResponseWarning = "response %q is not used anywhere"
 
+	// WrongRefKindWarning indicates a $ref to an object of another kind than expected, e.g. a parameter used as a schema.
+	WrongRefKindWarning = "in %s, $ref %s refers to a %s, but a %s is expected here"
+
 	// DubiousAbsoluteRefWarning flags a $ref pointing to an absolute local file location that escapes the
 	// spec's base path. Absolute local references are legitimate when they stay beneath the base path
 	// (flattening/expansion introduces such anchors for cyclical $refs), but an absolute reference that
@@ -260,6 +510,10 @@ func requiredButNotDefinedMsg(path, definition string) errors.Error {
 	return errors.New(errors.CompositeErrorCode, RequiredButNotDefinedError, path, definition)
 }
 
+func requiredNotDefinedInCompositionMsg(path, definition string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, RequiredNotDefinedInCompositionError, path, definition)
+}
+
 func pathParamGarbledMsg(path, param string) errors.Error {
 	return errors.New(errors.CompositeErrorCode, PathParamGarbledWarning, path, param)
 }
@@ -376,8 +630,12 @@ func examplesWithoutSchemaMsg(operation, response string) errors.Error {
 	return errors.New(errors.CompositeErrorCode, ExamplesWithoutSchemaWarning, operation, response)
 }
 
-func examplesMimeNotSupportedMsg(operation, response string) errors.Error {
-	return errors.New(errors.CompositeErrorCode, ExamplesMimeNotSupportedWarning, operation, response)
+func examplesMimeNotSupportedMsg(mediaType, operation, response string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, ExamplesMediaTypeNotSupportedWarning, mediaType, operation, response)
+}
+
+func exampleMediaTypeParseMsg(operation, response, mediaType string, err error) errors.Error {
+	return errors.New(errors.CompositeErrorCode, ExampleMediaTypeParseWarning, operation, response, mediaType, err)
 }
 
 func refNotAllowedInHeaderMsg(path, header, ref string) errors.Error {
@@ -413,8 +671,8 @@ func someParametersBrokenMsg(path, method, operationID string) errors.Error {
 	return errors.New(errors.CompositeErrorCode, SomeParametersBrokenError, path, method, operationID)
 }
 
-func refShouldNotHaveSiblingsMsg(path, operationID string) errors.Error {
-	return errors.New(errors.CompositeErrorCode, RefShouldNotHaveSiblingsWarning, operationID, path)
+func refSiblingsIgnoredMsg(referrer, ref string, siblings []string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, RefSiblingsIgnoredWarning, referrer, ref, strings.Join(siblings, ", "))
 }
 
 func dubiousAbsoluteRefMsg(ref string) errors.Error {
@@ -424,3 +682,267 @@ func dubiousAbsoluteRefMsg(ref string) errors.Error {
 func dubiousMultipleHostsMsg(count int, hosts string) errors.Error {
 	return errors.New(errors.CompositeErrorCode, DubiousMultipleHostsWarning, count, hosts)
 }
+
+func fileParamNotInFormDataMsg(operation, param, in string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, FileParamNotInFormDataError, operation, param, in)
+}
+
+func fileParamRequiresFormConsumesMsg(operation string, params []string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, FileParamRequiresFormConsumesError, operation, params)
+}
+
+func fileTypeNotAllowedMsg(path, operation string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, FileTypeNotAllowedError, path, operation)
+}
+
+func invalidMediaTypeMsg(in, mediaType string, err error) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidMediaTypeError, in, mediaType, err)
+}
+
+func fileResponseProducesMsg(operation, response string, produces []string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, FileResponseProducesWarning, operation, response, produces)
+}
+
+func formDataRequiresFormConsumesMsg(operation string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, FormDataRequiresFormConsumesWarning, operation)
+}
+
+func duplicateResponseHeaderMsg(operation, response string, headers []string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, DuplicateResponseHeaderError, operation, response, headers)
+}
+
+func invalidResponseCodeMsg(operation, code string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidResponseCodeError, operation, code)
+}
+
+func headResponseHasSchemaMsg(operation, response string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, HeadResponseHasSchemaWarning, operation, response)
+}
+
+func noSuccessResponseMsg(operation string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, NoSuccessResponseWarning, operation)
+}
+
+func responseWithoutBodyHasSchemaMsg(operation, response string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, ResponseWithoutBodyHasSchemaWarning, operation, response)
+}
+
+func pathCaseOnlyMsg(path, other string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathCaseOnlyWarning, path, other)
+}
+
+func pathParamPatternMatchesLiteralMsg(path, other, method, segment, pattern, param string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathParamPatternMatchesLiteralWarning, path, other, method, segment, pattern, param)
+}
+
+func pathParamShadowsLiteralMsg(path, other, method, segment, otherSegment string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathParamShadowsLiteralWarning, path, other, method, segment, otherSegment)
+}
+
+func pathTrailingSlashMsg(path, other string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathTrailingSlashWarning, path, other)
+}
+
+func duplicateEnumValuesMsg(path, in string, values []any) errors.Error {
+	return errors.New(errors.CompositeErrorCode, DuplicateEnumValuesError, path, in, values)
+}
+
+func enumValueDoesNotValidateMsg(param, path string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, EnumValueDoesNotValidateError, param, path)
+}
+
+func enumValueItemsDoesNotValidateMsg(param, path string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, EnumValueItemsDoesNotValidateError, param, path)
+}
+
+func enumValueHeaderDoesNotValidateMsg(operation, header, path string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, EnumValueHeaderDoesNotValidateError, operation, header, path)
+}
+
+func enumValueHeaderItemsDoesNotValidateMsg(operation, header, path string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, EnumValueHeaderItemsDoesNotValidateError, operation, header, path)
+}
+
+func enumValueInDoesNotValidateMsg(operation, path string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, EnumValueInDoesNotValidateError, operation, path)
+}
+
+func misplacedKeywordMsg(path, keyword, reason string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, MisplacedKeywordError, path, keyword, reason)
+}
+
+func unsupportedKeywordMsg(path, keyword, alternative string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UnsupportedKeywordError, path, keyword, alternative)
+}
+
+func brokenReferenceMsg(referrer, ref, reason string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, BrokenReferenceError, referrer, ref, reason)
+}
+
+func circularRefChainMsg(referrer, chain string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, CircularReferenceChainError, referrer, chain)
+}
+
+func wrongRefKindMsg(referrer, ref, actual, expected string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, WrongRefKindWarning, referrer, ref, actual, expected)
+}
+
+func allowEmptyValueNotAllowedMsg(operation, param, in string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, AllowEmptyValueNotAllowedError, operation, param, in)
+}
+
+func bodyParamWithoutBodySemanticsMsg(operation, param, method string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, BodyParamWithoutBodySemanticsWarning, operation, param, method)
+}
+
+func pathParamArrayWithoutCollectionFormatMsg(operation, param string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathParamArrayWithoutCollectionFormatWarning, operation, param)
+}
+
+func pathParamDefaultMsg(operation, param string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathParamDefaultWarning, operation, param)
+}
+
+func pathParamObjectTypeMsg(operation, param string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathParamObjectTypeError, operation, param)
+}
+
+func reservedHeaderParamMsg(operation, param, replacement string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, ReservedHeaderParamWarning, operation, param, replacement)
+}
+
+func hostWithPathMsg(host, path string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, HostWithPathWarning, host, path)
+}
+
+func invalidBasePathMsg(basePath, reason string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidBasePathError, basePath, reason)
+}
+
+func invalidHostMsg(host, reason string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidHostError, host, reason)
+}
+
+func invalidSchemeMsg(context, scheme, valid string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidSchemeError, context, scheme, valid)
+}
+
+func onlyInsecureSchemesMsg(context, schemes string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, OnlyInsecureSchemesWarning, context, schemes)
+}
+
+func goNameCollisionMsg(what, names, goName string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, GoNameCollisionError, what, names, goName)
+}
+
+func goReservedKeywordMsg(where, name, keyword string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, GoReservedKeywordWarning, where, name, keyword)
+}
+
+func invalidGoExtensionMsg(where, extension, reason string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidGoExtensionError, where, extension, reason)
+}
+
+func extensionDoesNotValidateMsg(where, extension string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, ExtensionDoesNotValidateError, where, extension)
+}
+
+func extensionNotAllowedMsg(where, extension, kind, expected string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, ExtensionNotAllowedError, where, extension, kind, expected)
+}
+
+func unknownExtensionMsg(where, extension string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UnknownExtensionError, where, extension)
+}
+
+func unsatisfiableRequiredCycleMsg(definition, cycle string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UnsatisfiableRequiredCycleError, definition, cycle)
+}
+
+func securityAPIKeyInQueryMsg(name string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, SecurityAPIKeyInQueryWarning, name)
+}
+
+func securityNoRequirementMsg(operation string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, SecurityNoRequirementWarning, operation)
+}
+
+func securityOAuth2ImplicitMsg(name string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, SecurityOAuth2ImplicitWarning, name)
+}
+
+func securityPasswordInResponseMsg(operation, response, property string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, SecurityPasswordInResponseWarning, operation, response, property)
+}
+
+func securityReadOnlyInRequestMsg(operation, param, property string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, SecurityReadOnlyInRequestWarning, operation, param, property)
+}
+
+func unboundedInRequestMsg(operation, where, in, reasons string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UnboundedInRequestWarning, operation, where, in, reasons)
+}
+
+func unboundedInResponseMsg(operation, where, response, reasons string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UnboundedInResponseWarning, operation, where, response, reasons)
+}
+
+func namingConventionMsg(kind, name, style string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, NamingConventionWarning, kind, name, style)
+}
+
+func pathSegmentConventionMsg(path, segment, style string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PathSegmentConventionWarning, path, segment, style)
+}
+
+func propertyNamingConventionMsg(property, schema, style string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, PropertyNamingConventionWarning, property, schema, style)
+}
+
+func duplicateTagMsg(tag string, count int) errors.Error {
+	return errors.New(errors.CompositeErrorCode, DuplicateTagWarning, tag, count)
+}
+
+func undeclaredTagMsg(operation, tag string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UndeclaredTagWarning, operation, tag)
+}
+
+func undocumentedDefinitionMsg(definition, what string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UndocumentedDefinitionWarning, definition, what)
+}
+
+func undocumentedInfoMsg(what string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UndocumentedInfoWarning, what)
+}
+
+func undocumentedOperationMsg(operation, what string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UndocumentedOperationWarning, operation, what)
+}
+
+func undocumentedParamMsg(operation, param, in string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UndocumentedParamWarning, operation, param, in)
+}
+
+func undocumentedResponseMsg(operation, response string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UndocumentedResponseWarning, operation, response)
+}
+
+func invalidExternalDocsURLMsg(where, url string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidExternalDocsURLWarning, where, url)
+}
+
+func duplicateDefinitionsMsg(definitions, keep string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, DuplicateDefinitionsWarning, definitions, keep)
+}
+
+func invalidSuppressionMsg(extension, pointer string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, InvalidSuppressionWarning, extension, pointer)
+}
+
+func staleSuppressionMsg(extension, pointer, rule string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, StaleSuppressionWarning, extension, pointer, rule)
+}
+
+func unknownSuppressedRuleMsg(extension, pointer, rule string) errors.Error {
+	return errors.New(errors.CompositeErrorCode, UnknownSuppressedRuleWarning, extension, pointer, rule)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// validSchemes lists the transfer protocols supported by Swagger 2.0.
+var validSchemes = []string{"http", "https", "ws", "wss"}
+
+// insecureSchemes lists the schemes which transfer data and credentials in clear text.
+var insecureSchemes = []string{"http", "ws"}
+
+// validateDocumentMetadata checks the host, basePath and schemes of the spec:
+//
+//   - host must be a host name or an IP address, with an optional port, without any scheme or path
+//   - basePath must begin with a slash and may not contain path templating
+//   - schemes must be one of http, https, ws or wss
+//
+// A host containing a path, and schemes listing only insecure schemes (http or ws), are reported as warnings.
+func (s *SpecValidator) validateDocumentMetadata() *Result {
+	res := pools.poolOfResults.BorrowResult()
+	sw := s.spec.Spec()
+
+	if sw.Host != "" {
+		res.Merge(validateHost(sw.Host).locate("/host"))
+	}
+
+	if sw.BasePath != "" {
+		switch {
+		case !strings.HasPrefix(sw.BasePath, "/"):
+			res.AddErrors(FindingAt("/basePath", invalidBasePathMsg(sw.BasePath, "it must begin with /")))
+		case strings.ContainsAny(sw.BasePath, "{}"):
+			res.AddErrors(FindingAt("/basePath", invalidBasePathMsg(sw.BasePath, "path templating is not allowed")))
+		}
+	}
+
+	res.Merge(validateSchemes(sw.Schemes, "global schemes").locate("/schemes"))
+
+	for method, pi := range s.analyzer.Operations() {
+		for path, op := range pi {
+			red := validateSchemes(op.Schemes, fmt.Sprintf("schemes in operation %q", op.ID))
+			res.Merge(red.locate(operationPointer(method, path) + "/schemes"))
+		}
+	}
+
+	return res
+}
+
+func validateHost(host string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	if scheme, _, found := strings.Cut(host, "://"); found {
+		res.AddErrors(invalidHostMsg(host, fmt.Sprintf("the scheme %s should be declared in schemes", scheme)))
+
+		return res
+	}
+
+	if hostPort, path, found := strings.Cut(host, "/"); found {
+		res.AddWarnings(hostWithPathMsg(host, "/"+path))
+		host = hostPort
+	}
+
+	name := host
+	if h, port, err := net.SplitHostPort(host); err == nil {
+		name = h
+		if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+			res.AddErrors(invalidHostMsg(host, fmt.Sprintf("invalid port %q", port)))
+		}
+	} else if strings.Count(host, ":") == 1 {
+		res.AddErrors(invalidHostMsg(host, err.Error()))
+
+		return res
+	}
+
+	if net.ParseIP(strings.Trim(name, "[]")) == nil && !strfmt.IsHostname(name) {
+		res.AddErrors(invalidHostMsg(host, fmt.Sprintf("%q is neither a host name nor an IP address", name)))
+	}
+
+	return res
+}
+
+func validateSchemes(schemes []string, context string) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	for _, scheme := range schemes {
+		if !slices.Contains(validSchemes, scheme) {
+			res.AddErrors(invalidSchemeMsg(context, scheme, strings.Join(validSchemes, ", ")))
+		}
+	}
+
+	if len(schemes) > 0 && !slices.ContainsFunc(schemes, func(scheme string) bool { return !slices.Contains(insecureSchemes, scheme) }) {
+		res.AddWarnings(onlyInsecureSchemesMsg(context, strings.Join(slices.Compact(slices.Sorted(slices.Values(schemes))), ", ")))
+	}
+
+	return res
+}
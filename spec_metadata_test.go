@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+)
+
+func TestValidateHost(t *testing.T) {
+	for _, host := range []string{"example.com", "api.example.com:8080", "localhost", "127.0.0.1:80", "[::1]:8443", "::1"} {
+		res := validateHost(host)
+		assert.TrueTf(t, res.IsValid(), "expected host %q to be valid", host)
+		assert.Emptyf(t, res.Warnings, "expected no warning for host %q", host)
+	}
+
+	for host, msg := range map[string]string{
+		"https://example.com":  `host "https://example.com" is invalid: the scheme https should be declared in schemes`,
+		"example.com:":         `host "example.com:" is invalid: invalid port ""`,
+		"example.com:99999":    `host "example.com:99999" is invalid: invalid port "99999"`,
+		"example.com:http":     `host "example.com:http" is invalid: invalid port "http"`,
+		"exa mple.com":         `host "exa mple.com" is invalid: "exa mple.com" is neither a host name nor an IP address`,
+		"{tenant}.example.com": `host "{tenant}.example.com" is invalid: "{tenant}.example.com" is neither a host name nor an IP address`,
+	} {
+		assert.SliceContainsT(t, verifiedTestErrors(validateHost(host)), msg)
+	}
+
+	res := validateHost("example.com:8080/api/v1")
+	assert.TrueT(t, res.IsValid())
+	assert.Equal(t, []string{`host "example.com:8080/api/v1" contains the path "/api/v1", which should be declared in basePath`}, verifiedTestWarnings(res))
+}
+
+func TestSpec_ValidateDocumentMetadata(t *testing.T) {
+	t.Run("should accept valid metadata", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-metadata-good.yaml")
+		assert.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should report invalid metadata", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-metadata.yaml")
+		assert.FalseT(t, res.IsValid())
+
+		errs := verifiedTestErrors(res)
+		for _, msg := range []string{
+			`host "http://api.example.com" is invalid: the scheme http should be declared in schemes`,
+			`basePath "/v1/{tenant}" is invalid: path templating is not allowed`,
+			`in schemes in operation "getPets", scheme "ftp" is invalid: expected one of http, https, ws, wss`,
+		} {
+			assert.SliceContainsT(t, errs, msg)
+		}
+		warnings := verifiedTestWarnings(res)
+		assert.SliceContainsT(t, warnings, `global schemes only list insecure schemes (http): consider https or wss`)
+		assert.SliceContainsT(t, warnings, `schemes in operation "addPet" only list insecure schemes (http, ws): consider https or wss`)
+	})
+}
@@ -32,7 +32,8 @@ const minDistinctHostsToWarn = 2
 //     two or more distinct hosts, a single aggregate warning lists them. A single consistent
 //     remote host is common and legitimate, so it is not flagged.
 //
-// All findings are warnings: they do not affect validity (see Result.IsValid).
+// All findings are warnings: they do not affect validity (see Result.IsValid). They are located at the root
+// of the spec, since each of them may be about several $ref.
 func (s *SpecValidator) validateDubiousRefs() *Result {
 	res := pools.poolOfResults.BorrowResult()
 
@@ -48,7 +49,7 @@ func (s *SpecValidator) validateDubiousRefs() *Result {
 		// Rule 1: absolute local reference escaping the base path.
 		if refPath, isLocalAbs := absoluteLocalRefPath(r, u); isLocalAbs {
 			if !hasBase || !isBeneathBase(refPath, baseDir) {
-				res.AddWarnings(dubiousAbsoluteRefMsg(r.String()))
+				res.AddWarnings(FindingAt("", dubiousAbsoluteRefMsg(r.String())))
 			}
 			continue
 		}
@@ -65,7 +66,7 @@ func (s *SpecValidator) validateDubiousRefs() *Result {
 			hosts = append(hosts, h)
 		}
 		sort.Strings(hosts)
-		res.AddWarnings(dubiousMultipleHostsMsg(len(hosts), strings.Join(hosts, ", ")))
+		res.AddWarnings(FindingAt("", dubiousMultipleHostsMsg(len(hosts), strings.Join(hosts, ", "))))
 	}
 
 	return res
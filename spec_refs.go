@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+// refTargetName returns the name of the kind of object a $ref designates at a node of some kind,
+// or an empty string where Swagger 2.0 does not allow $ref.
+func refTargetName(kind specKind) string {
+	switch kind {
+	case specKindSchema:
+		return "schema"
+	case specKindParameter:
+		return "parameter"
+	case specKindResponse:
+		return "response"
+	case specKindPathItem:
+		return "path item"
+	default:
+		return ""
+	}
+}
+
+// refResolver resolves $ref found in a swagger document, and the documents it refers to.
+type refResolver struct {
+	base      string
+	root      any
+	documents map[string]any
+	loadErrs  map[string]error
+	loops     map[string]struct{}
+}
+
+// validateReferencesResolution reports every $ref which cannot be resolved, with the JSON pointer of the referrer,
+// the target and the reason:
+//
+//   - the document referred to cannot be loaded
+//   - the JSON pointer does not designate any object in the target document
+//   - chains of $ref which loop without ever reaching an actual object, e.g. a definition referring to itself
+//
+// A $ref to an object of the wrong kind in the spec, e.g. a parameter used as a schema, is reported as a warning.
+//
+// $ref are collected on the raw document, only in places where Swagger 2.0 allows them.
+func (s *SpecValidator) validateReferencesResolution() *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	var root any
+	if err := json.Unmarshal(s.spec.Raw(), &root); err != nil {
+		return res
+	}
+
+	r := &refResolver{
+		base:      s.spec.SpecFilePath(),
+		root:      root,
+		documents: make(map[string]any),
+		loadErrs:  make(map[string]error),
+		loops:     make(map[string]struct{}),
+	}
+	walkRefs(root, func(referrer, ref string, expected specKind, _ map[string]any) {
+		red := pools.poolOfResults.BorrowResult()
+		r.check(referrer, ref, expected, red)
+		res.Merge(red.locate(strings.TrimPrefix(referrer, "#")))
+	})
+
+	return res
+}
+
+// walkRefs calls visit for every $ref found in a swagger document, in places where Swagger 2.0 allows them.
+//
+// The visitor receives the JSON pointer of the referrer, the $ref, the kind of object expected there and
+// the object holding the $ref.
+func walkRefs(doc any, visit func(referrer, ref string, expected specKind, holder map[string]any)) {
+	walkSpec(doc, "", specKindRoot, func(pointer string, kind specKind, object map[string]any) bool {
+		ref, isRef := object["$ref"].(string)
+		if !isRef || refTargetName(kind) == "" {
+			return true
+		}
+
+		visit("#"+pointer, ref, kind, object)
+
+		return false
+	})
+}
+
+// check resolves a $ref, following chains of $ref, and reports the first problem found.
+func (r *refResolver) check(referrer, ref string, expected specKind, res *Result) {
+	location := r.base
+	chain := []string{referrer}
+	visited := make(map[string]struct{})
+
+	for {
+		target, err := r.locate(location, ref)
+		if err != nil {
+			// invalid URIs are reported by validateReferencesValid
+			return
+		}
+
+		key := target.location + "#" + target.fragment
+		chain = append(chain, ref)
+		if _, seen := visited[key]; seen {
+			r.reportLoop(referrer, chain, res)
+
+			return
+		}
+		visited[key] = struct{}{}
+
+		doc, err := r.document(target.location)
+		if err != nil {
+			if len(chain) == 2 || !r.isRoot(target.location) {
+				res.AddErrors(brokenReferenceMsg(referrer, ref, refCannotLoadReason(target.location, err)))
+			}
+
+			return
+		}
+
+		pointer, err := jsonpointer.New(target.fragment)
+		if err != nil {
+			res.AddErrors(brokenReferenceMsg(referrer, ref, refBadPointerReason(target.fragment, err)))
+
+			return
+		}
+
+		value, _, err := pointer.Get(doc)
+		if err != nil {
+			// a broken link in a chain within the spec is reported for its own referrer
+			if len(chain) == 2 || !r.isRoot(target.location) {
+				res.AddErrors(brokenReferenceMsg(referrer, strings.Join(chain[1:], " -> "), refBadPointerReason(target.fragment, err)))
+			}
+
+			return
+		}
+
+		// the kind of object may only be inferred in the spec: other documents need not be swagger specs
+		if actual := specKindAt(pointer.DecodedTokens()); r.isRoot(target.location) && refTargetName(actual) != "" && actual != expected {
+			res.AddWarnings(wrongRefKindMsg(referrer, ref, refTargetName(actual), refTargetName(expected)))
+
+			return
+		}
+
+		next, isRef := asObject(value)["$ref"].(string)
+		if !isRef {
+			return
+		}
+
+		// ref-to-ref: carry on with the next link of the chain, relative to the current document
+		location, ref = target.location, next
+	}
+}
+
+func (r *refResolver) reportLoop(referrer string, chain []string, res *Result) {
+	members := slices.Clone(chain[1:])
+	sort.Strings(members)
+	key := strings.Join(slices.Compact(members), " ")
+	if _, reported := r.loops[key]; reported {
+		return
+	}
+	r.loops[key] = struct{}{}
+
+	res.AddErrors(circularRefChainMsg(referrer, strings.Join(chain, " -> ")))
+}
+
+// refTarget is the resolved location of a $ref: the document and the JSON pointer within that document.
+//
+// An empty location stands for the spec itself.
+type refTarget struct {
+	location string
+	fragment string
+}
+
+// locate resolves a $ref relative to the document it is found in.
+func (r *refResolver) locate(location, ref string) (refTarget, error) {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return refTarget{}, err
+	}
+
+	if u.Scheme == "" && u.Host == "" && u.Path == "" {
+		return refTarget{location: location, fragment: u.Fragment}, nil
+	}
+
+	fragment := u.Fragment
+	u.Fragment = ""
+
+	switch {
+	case u.IsAbs() || u.Host != "":
+		return refTarget{location: u.String(), fragment: fragment}, nil
+	case location == "" && r.base == "":
+		return refTarget{location: u.Path, fragment: fragment}, nil
+	}
+
+	base := location
+	if base == "" {
+		base = r.base
+	}
+
+	if baseURL, err := url.Parse(base); err == nil && (baseURL.Scheme == "http" || baseURL.Scheme == "https") {
+		return refTarget{location: baseURL.ResolveReference(u).String(), fragment: fragment}, nil
+	}
+
+	if path.IsAbs(u.Path) || filepath.IsAbs(u.Path) {
+		return refTarget{location: u.Path, fragment: fragment}, nil
+	}
+
+	return refTarget{location: filepath.Join(filepath.Dir(base), filepath.FromSlash(u.Path)), fragment: fragment}, nil
+}
+
+// isRoot tells if a location is the spec itself.
+func (r *refResolver) isRoot(location string) bool {
+	return location == "" || location == r.base
+}
+
+// document returns the document at some location, loading it when needed.
+func (r *refResolver) document(location string) (any, error) {
+	if r.isRoot(location) {
+		return r.root, nil
+	}
+
+	if doc, loaded := r.documents[location]; loaded {
+		return doc, nil
+	}
+	if err, failed := r.loadErrs[location]; failed {
+		return nil, err
+	}
+
+	raw, err := spec.PathLoader(location)
+	var doc any
+	if err == nil {
+		err = json.Unmarshal(raw, &doc)
+	}
+	if err != nil {
+		r.loadErrs[location] = err
+
+		return nil, err
+	}
+	r.documents[location] = doc
+
+	return doc, nil
+}
+
+func refCannotLoadReason(location string, err error) string {
+	return fmt.Sprintf("cannot load document %s: %v", location, err)
+}
+
+func refBadPointerReason(fragment string, err error) string {
+	return fmt.Sprintf("JSON pointer %q cannot be resolved: %v", fragment, err)
+}
+
+// validateRefNoSibling reports keywords declared next to a $ref in schemas, parameters and responses.
+// These keywords are ignored, e.g. a description or readOnly added next to the $ref to a definition.
+//
+// Findings are warnings, unless the StrictRefSiblings option is enabled. Vendor extensions are not reported
+// when the IgnoreRefSiblingExtensions option is enabled.
+//
+// The check is carried out on the raw document, since the spec package discards the siblings of a $ref.
+func (s *SpecValidator) validateRefNoSibling(doc any) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	report := res.AddWarnings
+	if s.Options.StrictRefSiblings {
+		report = res.AddErrors
+	}
+
+	walkRefs(doc, func(referrer, ref string, expected specKind, holder map[string]any) {
+		if expected == specKindPathItem {
+			return
+		}
+
+		if siblings := s.refSiblings(holder); len(siblings) > 0 {
+			report(FindingAt(strings.TrimPrefix(referrer, "#"), refSiblingsIgnoredMsg(referrer, ref, siblings)))
+		}
+	})
+
+	return res
+}
+
+// refSiblings returns the sorted keys declared next to a $ref.
+func (s *SpecValidator) refSiblings(node map[string]any) []string {
+	siblings := make([]string, 0, len(node)-1)
+	for _, key := range sortedKeys(node) {
+		if key == "$ref" || (s.Options.IgnoreRefSiblingExtensions && strings.HasPrefix(strings.ToLower(key), "x-")) {
+			continue
+		}
+		siblings = append(siblings, key)
+	}
+
+	return siblings
+}
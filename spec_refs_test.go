@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpecKindAt(t *testing.T) {
+	for pointer, expected := range map[string]specKind{
+		"/definitions/pet":                                     specKindSchema,
+		"/definitions/pet/properties/tags/items":               specKindSchema,
+		"/definitions/pet/items/0/allOf/1":                     specKindSchema,
+		"/parameters/limit":                                    specKindParameter,
+		"/responses/notFound":                                  specKindResponse,
+		"/paths/~1pets":                                        specKindPathItem,
+		"/paths/~1pets/get/parameters/0":                       specKindParameter,
+		"/paths/~1pets/get/responses/200":                      specKindResponse,
+		"/paths/~1pets/get/responses/200/schema":               specKindSchema,
+		"/paths/~1pets/get/responses/200/headers/X-Rate-Limit": specKindHeader,
+		"/info":         specKindInfo,
+		"/info/contact": specKindUnknown,
+	} {
+		tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+		for i := range tokens {
+			tokens[i] = strings.ReplaceAll(tokens[i], "~1", "/")
+		}
+		assert.Equalf(t, expected, specKindAt(tokens), "unexpected kind for %s", pointer)
+	}
+}
+
+func TestSpec_ValidateReferencesResolution(t *testing.T) {
+	t.Run("should report every broken $ref", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-refs-broken.yaml")
+		assert.FalseT(t, res.IsValid())
+
+		errs := verifiedTestErrors(res)
+		for _, msg := range []string{
+			`in #/paths/~1pets/get/parameters/0, $ref #/parameters/missing cannot be resolved: JSON pointer "/parameters/missing" cannot be resolved: object has no key "parameters": JSON pointer error`,
+			`in #/definitions/pet/properties/owner, $ref #/definitions/owner cannot be resolved: JSON pointer "/definitions/owner" cannot be resolved: object has no key "owner": JSON pointer error`,
+			`in #/definitions/alias, $ref #/definitions/tag cannot be resolved: JSON pointer "/definitions/tag" cannot be resolved: object has no key "tag": JSON pointer error`,
+		} {
+			assert.SliceContainsT(t, errs, msg)
+		}
+
+		for _, msg := range errs {
+			// broken links in a chain are reported once, for their own referrer
+			assert.FalseT(t, strings.HasPrefix(msg, "in #/definitions/pet/properties/tag,"))
+		}
+	})
+
+	t.Run("should report circular chains of $ref", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-refs-circular.yaml")
+		assert.FalseT(t, res.IsValid())
+
+		var loops []string
+		for _, msg := range verifiedTestErrors(res) {
+			if strings.Contains(msg, "circular chain") {
+				loops = append(loops, msg)
+			}
+		}
+		assert.Equal(t, []string{
+			`in #/definitions/a, circular chain of $ref without any actual object: #/definitions/a -> #/definitions/b -> #/definitions/a -> #/definitions/b`,
+			`in #/definitions/self, circular chain of $ref without any actual object: #/definitions/self -> #/definitions/self -> #/definitions/self`,
+		}, loops)
+	})
+
+	t.Run("should warn about $ref to the wrong kind of object", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-refs-wrong-kind.yaml")
+		assert.SliceContainsT(t, verifiedTestWarnings(res),
+			`in #/paths/~1pets/get/responses/200/schema, $ref #/parameters/limit refers to a parameter, but a schema is expected here`)
+	})
+
+	t.Run("should report other documents which cannot be loaded or hold no such object", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "models.json"), []byte(`{"pet": {"type": "object"}}`), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "spec.json"), []byte(`{
+			"swagger": "2.0",
+			"info": {"title": "t", "version": "1"},
+			"paths": {
+				"/pets": {
+					"get": {
+						"responses": {
+							"200": {"description": "ok", "schema": {"$ref": "models.json#/pet"}},
+							"201": {"description": "ok", "schema": {"$ref": "models.json#/dog"}},
+							"404": {"$ref": "does-not-exist.json#/responses/notFound"}
+						}
+					}
+				}
+			}
+		}`), 0o600))
+
+		doc, err := loads.Spec(filepath.Join(dir, "spec.json"))
+		require.NoError(t, err)
+		validator := NewSpecValidator(doc.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		res, _ := validator.Validate(doc)
+
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs,
+			`in #/paths/~1pets/get/responses/201/schema, $ref models.json#/dog cannot be resolved: JSON pointer "/dog" cannot be resolved: object has no key "dog": JSON pointer error`)
+
+		var loadErrs int
+		for _, msg := range errs {
+			if strings.HasPrefix(msg, "in #/paths/~1pets/get/responses/404, $ref does-not-exist.json#/responses/notFound cannot be resolved: cannot load document ") {
+				loadErrs++
+			}
+		}
+		assert.EqualT(t, 1, loadErrs)
+	})
+}
+
+func TestSpec_ValidateRefNoSibling(t *testing.T) {
+	messages := []string{
+		`in #/paths/~1pets/get/parameters/0, keywords next to $ref #/parameters/limit are ignored: required`,
+		`in #/paths/~1pets/get/responses/200/schema, keywords next to $ref #/definitions/pet are ignored: description`,
+		`in #/paths/~1pets/get/responses/default, keywords next to $ref #/responses/error are ignored: x-go-name`,
+		`in #/definitions/pet/properties/owner, keywords next to $ref #/definitions/owner are ignored: readOnly, x-nullable`,
+	}
+
+	t.Run("should warn about keywords next to a $ref", func(t *testing.T) {
+		// siblings of a $ref to a parameter or a response are also rejected by the swagger schema
+		res, _ := loadFixtureAndValidate(t, "fixture-ref-siblings.yaml")
+
+		warnings := verifiedTestWarnings(res)
+		for _, msg := range messages {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+	})
+
+	t.Run("should report errors in strict mode", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-ref-siblings.yaml", func(o *Opts) { o.StrictRefSiblings = true })
+
+		errs := verifiedTestErrors(res)
+		for _, msg := range messages {
+			assert.SliceContainsT(t, errs, msg)
+		}
+	})
+
+	t.Run("should ignore vendor extensions when configured", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-ref-siblings.yaml", func(o *Opts) { o.IgnoreRefSiblingExtensions = true })
+
+		warnings := verifiedTestWarnings(res)
+		assert.SliceContainsT(t, warnings,
+			`in #/definitions/pet/properties/owner, keywords next to $ref #/definitions/owner are ignored: readOnly`)
+		for _, msg := range warnings {
+			assert.FalseT(t, strings.Contains(msg, "#/responses/error are ignored"))
+		}
+	})
+}
+
+func TestSpec_ValidateRefNoSiblingOnce(t *testing.T) {
+	// a parameter taken over by the schema of its $ref is reported once, by the ref-siblings rule
+	res, _ := loadFixtureAndValidate(t, "fixture-342.yaml")
+
+	var siblings []string
+	for _, msg := range verifiedTestWarnings(res) {
+		if strings.Contains(msg, "sibling") || strings.Contains(msg, "are ignored") {
+			siblings = append(siblings, msg)
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		`in #/paths/~1get_main_object/get/parameters/0, keywords next to $ref #/definitions/sample_info/properties/sid are ignored: in, name, required`,
+		`in #/paths/~1get_main_object/get/parameters/4, keywords next to $ref nowhere.yaml#/definitions/sample_info/properties/sid are ignored: in, name, required`,
+		`in #/paths/~1get_main_object/get/parameters/5, keywords next to $ref #/definitions/sample_info/properties/sid are ignored: in, name, required`,
+	}, siblings)
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+const definitionsPrefix = "#/definitions/"
+
+// requiredEdge is a reference from a definition to another one, which any valid document must follow.
+type requiredEdge struct {
+	target string // name of the definition referred to
+	via    string // path from the definition to the $ref, e.g. ".child" or ".children.items"
+}
+
+// validateRequiredCycles reports definitions which no finite document can satisfy, because they require,
+// directly or not, an instance of themselves. For example:
+//
+//	Node:
+//	  type: object
+//	  required: [child]
+//	  properties:
+//	    child:
+//	      $ref: '#/definitions/Node'
+//
+// Definitions are required through required properties, array items with minItems >= 1, and allOf.
+// Properties declared with x-nullable: true break the cycle, since they may be null.
+func (s *SpecValidator) validateRequiredCycles() *Result {
+	res := pools.poolOfResults.BorrowResult()
+	definitions := s.spec.Spec().Definitions
+
+	graph := make(map[string][]requiredEdge, len(definitions))
+	for name, schema := range definitions {
+		graph[name] = requiredEdges(&schema, "", nil)
+	}
+
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	state := make(map[string]int, len(graph))
+	reported := make(map[string]struct{})
+
+	var (
+		stack []string
+		vias  []string
+		visit func(name string)
+	)
+	visit = func(name string) {
+		state[name] = onStack
+		stack = append(stack, name)
+
+		for _, edge := range graph[name] {
+			if _, defined := graph[edge.target]; !defined {
+				// unresolved references are reported elsewhere
+				continue
+			}
+
+			switch state[edge.target] {
+			case unvisited:
+				vias = append(vias, edge.via)
+				visit(edge.target)
+				vias = vias[:len(vias)-1]
+			case onStack:
+				start := slices.Index(stack, edge.target)
+				cycle := slices.Clone(stack[start:])
+				links := append(slices.Clone(vias[start:]), edge.via)
+				reportRequiredCycle(cycle, links, reported, res)
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[name] = done
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(graph)) {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+
+	return res
+}
+
+// reportRequiredCycle reports a cycle once, starting from its first definition in lexicographic order.
+func reportRequiredCycle(cycle, links []string, reported map[string]struct{}, res *Result) {
+	first := 0
+	for i, name := range cycle {
+		if name < cycle[first] {
+			first = i
+		}
+	}
+
+	var path strings.Builder
+	for i := range cycle {
+		j := (first + i) % len(cycle)
+		path.WriteString(cycle[j])
+		path.WriteString(links[j])
+		path.WriteString(" -> ")
+	}
+	path.WriteString(cycle[first])
+
+	key := path.String()
+	if _, done := reported[key]; done {
+		return
+	}
+	reported[key] = struct{}{}
+
+	res.AddErrors(FindingAt(definitionPointer(cycle[first]), unsatisfiableRequiredCycleMsg(cycle[first], key)))
+}
+
+// requiredEdges collects the local $ref to definitions which any instance of a schema must follow.
+func requiredEdges(schema *spec.Schema, via string, edges []requiredEdge) []requiredEdge {
+	if schema == nil {
+		return edges
+	}
+
+	if ref := schema.Ref.String(); ref != "" {
+		if target, isLocal := strings.CutPrefix(ref, definitionsPrefix); isLocal {
+			edges = append(edges, requiredEdge{target: jsonpointer.Unescape(target), via: via})
+		}
+
+		return edges
+	}
+
+	for i := range schema.AllOf {
+		edges = requiredEdges(&schema.AllOf[i], via+".allOf."+strconv.Itoa(i), edges)
+	}
+
+	for _, name := range schema.Required {
+		property, isDefined := schema.Properties[name]
+		if !isDefined || isNullable(&property) {
+			continue
+		}
+
+		edges = requiredEdges(&property, via+"."+name, edges)
+	}
+
+	if schema.Items != nil && schema.MinItems != nil && *schema.MinItems >= 1 {
+		if schema.Items.Schema != nil {
+			edges = requiredEdges(schema.Items.Schema, via+".items", edges)
+		}
+
+		for i := range schema.Items.Schemas {
+			if int64(i) >= *schema.MinItems {
+				break
+			}
+			edges = requiredEdges(&schema.Items.Schemas[i], via+".items."+strconv.Itoa(i), edges)
+		}
+	}
+
+	return edges
+}
+
+// isNullable tells if a schema admits null values, with the x-nullable or x-isnullable extensions.
+func isNullable(schema *spec.Schema) bool {
+	for _, extension := range []string{xNullable, xIsNullable} {
+		if value, ok := extensionValue(schema.Extensions, extension); ok {
+			if nullable, isBool := value.(bool); isBool && nullable {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+)
+
+func TestSpec_ValidateRequiredCycles(t *testing.T) {
+	res, _ := loadFixtureAndValidate(t, "fixture-required-cycles.yaml")
+	assert.FalseT(t, res.IsValid())
+
+	var cycles []string
+	for _, msg := range verifiedTestErrors(res) {
+		if strings.Contains(msg, "required cycle") {
+			cycles = append(cycles, msg)
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		`definition "Node" cannot be satisfied by any finite document: required cycle Node.child -> Node`,
+		`definition "Branch" cannot be satisfied by any finite document: required cycle Branch.allOf.0.tree -> Tree.children.items -> Branch`,
+	}, cycles)
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+const (
+	minStatusCode = 100
+	maxStatusCode = 599
+)
+
+// validateResponses checks the semantics of response objects:
+//
+//   - response codes must be valid HTTP status codes (100 to 599), or default (error)
+//   - response headers must not differ only by case (error)
+//   - a 204 or 304 response should not declare a schema (warning)
+//   - a response to a HEAD operation should not declare a schema (warning)
+//   - an operation should declare at least one success response, or a default response (warning)
+//
+// Response codes which are not integers are dropped when unmarshalling the spec: they
+// are checked on the raw document.
+func (s *SpecValidator) validateResponses(doc any) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	analyzer := s.expandedAnalyzer()
+
+	walkSpec(doc, "", specKindRoot, func(pointer string, kind specKind, object map[string]any) bool {
+		if kind != specKindResponses {
+			return true
+		}
+
+		// only the responses of operations are keyed by status code, e.g. /paths/~1pets/get/responses
+		const operationResponsesTokens = 4
+		tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+		if len(tokens) != operationResponsesTokens || tokens[0] != "paths" {
+			return false
+		}
+
+		op, ok := analyzer.OperationFor(strings.ToUpper(tokens[2]), jsonpointer.Unescape(tokens[1]))
+		if !ok {
+			return false
+		}
+
+		for _, code := range sortedKeys(object) {
+			if code != jsonDefault && !isExtension(code) && !isValidResponseCode(code) {
+				res.AddErrors(FindingAt(pointer+"/"+jsonpointer.Escape(code), invalidResponseCodeMsg(op.ID, code)))
+			}
+		}
+
+		return false
+	})
+
+	for method, pi := range analyzer.Operations() {
+		for path, op := range pi {
+			if op.Responses == nil {
+				// reported by the default and example validators
+				continue
+			}
+
+			var hasSuccess bool
+			if op.Responses.Default != nil {
+				hasSuccess = true
+				red := s.validateResponse(op.Responses.Default, jsonDefault, 0, method, path, op.ID)
+				res.Merge(red.locate(responsePointer(method, path, jsonDefault, 0)))
+			}
+
+			for code, resp := range op.Responses.StatusCodeResponses {
+				if code >= http.StatusOK && code < http.StatusBadRequest {
+					hasSuccess = true
+				}
+				red := s.validateResponse(&resp, "response", code, method, path, op.ID) //#nosec
+				res.Merge(red.locate(responsePointer(method, path, "response", code)))
+			}
+
+			if !hasSuccess {
+				res.AddWarnings(FindingAt(operationPointer(method, path)+"/responses", noSuccessResponseMsg(op.ID)))
+			}
+		}
+	}
+
+	return res
+}
+
+func (s *SpecValidator) validateResponse(resp *spec.Response, responseType string, code int, method, path, operationID string) *Result {
+	response, res := responseHelp.expandResponseRef(resp, path, s)
+	if !res.IsValid() {
+		return res
+	}
+
+	responseName, _ := responseHelp.responseMsgVariants(responseType, code)
+
+	if response.Schema != nil {
+		switch {
+		case method == http.MethodHead:
+			res.AddWarnings(headResponseHasSchemaMsg(operationID, responseName))
+		case code == http.StatusNoContent || code == http.StatusNotModified:
+			res.AddWarnings(responseWithoutBodyHasSchemaMsg(operationID, responseName))
+		}
+	}
+
+	folded := make(map[string][]string, len(response.Headers))
+	for hn := range response.Headers {
+		key := strings.ToLower(hn)
+		folded[key] = append(folded[key], hn)
+	}
+	for _, names := range folded {
+		if len(names) > 1 {
+			sort.Strings(names)
+			res.AddErrors(duplicateResponseHeaderMsg(operationID, responseName, names))
+		}
+	}
+
+	return res
+}
+
+// isValidResponseCode tells if a response key is a 3-digit HTTP status code, from 100 to 599.
+func isValidResponseCode(code string) bool {
+	const digits = 3
+	if len(code) != digits {
+		return false
+	}
+
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	value, err := strconv.Atoi(code)
+
+	return err == nil && value >= minStatusCode && value <= maxStatusCode
+}
+
+// isOperationMethod tells if an upper-cased path item key is an operation supported by Swagger 2.0.
+func isOperationMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodPost, http.MethodPatch,
+		http.MethodDelete, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestIsValidResponseCode(t *testing.T) {
+	for _, code := range []string{"100", "200", "204", "418", "599"} {
+		assert.TrueTf(t, isValidResponseCode(code), "expected %q to be a valid response code", code)
+	}
+
+	for _, code := range []string{"", "2XX", "099", "600", "2000", "20", "+20", "abc"} {
+		assert.FalseTf(t, isValidResponseCode(code), "expected %q to be an invalid response code", code)
+	}
+}
+
+func TestSpec_ValidateResponses(t *testing.T) {
+	t.Run("should accept well-formed responses", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-responses-good.yaml")
+		assert.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Errors)
+		assert.Empty(t, res.Warnings)
+	})
+
+	res, _ := loadFixtureAndValidate(t, "fixture-responses.yaml")
+
+	t.Run("should report invalid response codes", func(t *testing.T) {
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs, `in operation "getCodes", "2XX" is not a valid response code: expected an HTTP status code from 100 to 599, or default`)
+		assert.SliceContainsT(t, errs, `in operation "getCodes", "600" is not a valid response code: expected an HTTP status code from 100 to 599, or default`)
+	})
+
+	t.Run("should report response headers differing only by case", func(t *testing.T) {
+		assert.SliceContainsT(t, verifiedTestErrors(res),
+			`in operation "getHeaders", default response declares headers which differ only by case: [X-Request-Id x-request-id]`,
+		)
+	})
+
+	warnings := verifiedTestWarnings(res)
+	require.Len(t, warnings, 4)
+
+	t.Run("should warn about responses without body declaring a schema", func(t *testing.T) {
+		assert.SliceContainsT(t, warnings, `in operation "putA", response 204 declares a schema, but this status code conveys no response body`)
+		assert.SliceContainsT(t, warnings, `in operation "putA", response 304 declares a schema, but this status code conveys no response body`)
+		assert.SliceContainsT(t, warnings, `in operation "headA", response 200 declares a schema, but responses to HEAD requests have no body`)
+	})
+
+	t.Run("should warn about operations without success response", func(t *testing.T) {
+		assert.SliceContainsT(t, warnings,
+			`operation "getErrors" has no success response: expected at least one 2xx or 3xx response, or a default response`,
+		)
+	})
+}
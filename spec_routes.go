@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+)
+
+// validatePathAmbiguities reports paths which a router would not be able to tell apart, or
+// would route to the wrong operation:
+//
+//   - a literal segment shadowed by a path parameter for the same method, e.g. GET /users/me and GET /users/{id}
+//   - a literal segment matching the pattern of a path parameter for the same method
+//   - the same path with and without a trailing slash, e.g. /users and /users/
+//   - paths which differ only by case, e.g. /users and /Users
+//
+// A path parameter with a pattern which does not match the literal segment is not ambiguous.
+//
+// Paths which only differ by the names of their parameters are checked by validateParameters,
+// when StrictPathParamUniqueness is enabled.
+//
+// Findings are warnings, unless the StrictPathAmbiguity option is enabled. Paths shadowed by a path parameter are
+// located at their operation, other ambiguities at the first path in lexicographic order.
+func (s *SpecValidator) validatePathAmbiguities() *Result {
+	res := pools.poolOfResults.BorrowResult()
+	analyzer := s.expandedAnalyzer()
+
+	report := res.AddWarnings
+	if s.Options.StrictPathAmbiguity {
+		report = res.AddErrors
+	}
+
+	allPaths := make([]string, 0, len(analyzer.AllPaths()))
+	for path := range analyzer.AllPaths() {
+		allPaths = append(allPaths, path)
+	}
+	sort.Strings(allPaths)
+
+	for i, path := range allPaths {
+		for _, other := range allPaths[i+1:] {
+			switch {
+			case strings.TrimSuffix(path, "/") == strings.TrimSuffix(other, "/"):
+				report(FindingAt(pathPointer(path), pathTrailingSlashMsg(path, other)))
+			case strings.EqualFold(path, other):
+				report(FindingAt(pathPointer(path), pathCaseOnlyMsg(path, other)))
+			}
+		}
+	}
+
+	for method, pi := range analyzer.Operations() {
+		paths := make([]string, 0, len(pi))
+		params := make(map[string]map[string]spec.Parameter, len(pi))
+		for path := range pi {
+			paths = append(paths, path)
+			params[path] = pathParamsByName(analyzer.SafeParamsFor(method, path, func(spec.Parameter, error) bool {
+				// broken parameters are reported by validateParameters
+				return true
+			}))
+		}
+		sort.Strings(paths)
+
+		for i, path := range paths {
+			for _, other := range paths[i+1:] {
+				report(ambiguousPathsMsg(method, path, other, params[path], params[other]))
+			}
+		}
+	}
+
+	return res
+}
+
+// ambiguousPathsMsg returns a message whenever a literal segment in one path may be captured by
+// a path parameter in the other path, for the same method, located at the operation of the literal path.
+// It returns nil otherwise.
+func ambiguousPathsMsg(method, path, other string, params, otherParams map[string]spec.Parameter) error {
+	segments := strings.Split(path, "/")
+	otherSegments := strings.Split(other, "/")
+	if len(segments) != len(otherSegments) {
+		return nil
+	}
+
+	var msg error
+	for i, segment := range segments {
+		otherSegment := otherSegments[i]
+		if segment == otherSegment {
+			continue
+		}
+
+		isTemplated, isOtherTemplated := pathHelp.isTemplatedSegment(segment), pathHelp.isTemplatedSegment(otherSegment)
+		switch {
+		case !isTemplated && !isOtherTemplated:
+			// distinct literal segments: these paths never match the same request
+			return nil
+
+		case isTemplated && isOtherTemplated:
+			// both segments are templated: this is checked by StrictPathParamUniqueness
+			continue
+
+		case isTemplated:
+			shadow, ok := shadowedSegmentMsg(method, other, path, otherSegment, segment, params)
+			if !ok {
+				return nil
+			}
+			if msg == nil {
+				msg = FindingAt(operationPointer(method, other), shadow)
+			}
+
+		default:
+			shadow, ok := shadowedSegmentMsg(method, path, other, segment, otherSegment, otherParams)
+			if !ok {
+				return nil
+			}
+			if msg == nil {
+				msg = FindingAt(operationPointer(method, path), shadow)
+			}
+		}
+	}
+
+	return msg
+}
+
+// shadowedSegmentMsg tells if a templated segment may match a literal segment, and returns the corresponding message.
+//
+// A path parameter declaring a pattern only matches the literal segment if the pattern does.
+func shadowedSegmentMsg(method, literalPath, templatedPath, literal, templated string, params map[string]spec.Parameter) (errors.Error, bool) {
+	if !pathHelp.segmentMatches(templated, literal) {
+		return nil, false
+	}
+
+	name, isParam := pathHelp.segmentParam(templated)
+	if !isParam {
+		return pathParamShadowsLiteralMsg(literalPath, templatedPath, method, literal, templated), true
+	}
+
+	param, ok := params[name]
+	if !ok || param.Pattern == "" {
+		return pathParamShadowsLiteralMsg(literalPath, templatedPath, method, literal, templated), true
+	}
+
+	rex, err := compileRegexp(param.Pattern)
+	if err != nil {
+		// invalid patterns are reported by validateParameters
+		return pathParamShadowsLiteralMsg(literalPath, templatedPath, method, literal, templated), true
+	}
+
+	if !rex.MatchString(literal) {
+		return nil, false
+	}
+
+	return pathParamPatternMatchesLiteralMsg(literalPath, templatedPath, method, literal, param.Pattern, name), true
+}
+
+func pathParamsByName(params map[string]spec.Parameter) map[string]spec.Parameter {
+	byName := make(map[string]spec.Parameter, len(params))
+	for _, param := range params {
+		if param.In == "path" {
+			byName[param.Name] = param
+		}
+	}
+
+	return byName
+}
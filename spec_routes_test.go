@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestPathHelper_Segments(t *testing.T) {
+	name, ok := pathHelp.segmentParam("{id}")
+	require.TrueT(t, ok)
+	assert.EqualT(t, "id", name)
+
+	for _, segment := range []string{"id", "{}", "{id}.json", "{a}{b}"} {
+		_, ok = pathHelp.segmentParam(segment)
+		assert.FalseTf(t, ok, "expected %q not to be a single path param", segment)
+	}
+
+	assert.TrueT(t, pathHelp.segmentMatches("{id}", "me"))
+	assert.TrueT(t, pathHelp.segmentMatches("{name}.json", "pet.json"))
+	assert.FalseT(t, pathHelp.segmentMatches("{name}.json", "pet.xml"))
+	assert.TrueT(t, pathHelp.segmentMatches("v{major}.{minor}", "v1.2"))
+	assert.FalseT(t, pathHelp.segmentMatches("v{major}.{minor}", "v1"))
+}
+
+func TestSpec_ValidatePathAmbiguities(t *testing.T) {
+	expected := []string{
+		`path /users/me is ambiguous with path /users/{id} for method GET: literal segment "me" may be captured by segment "{id}"`,
+		`path /items/new is ambiguous with path /items/{code} for method GET: literal segment "new" matches the pattern "^[a-z]+$" of path param "code"`,
+		`path /pets differs from path /pets/ only by a trailing slash`,
+		`path /Stores differs from path /stores only by case`,
+	}
+
+	t.Run("should warn about ambiguous paths", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-path-ambiguities.yaml")
+		assert.TrueT(t, res.IsValid())
+		warnings := verifiedTestWarnings(res)
+		assert.Len(t, warnings, len(expected))
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+	})
+
+	t.Run("should report ambiguous paths as errors in strict mode", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-path-ambiguities.yaml", func(o *Opts) {
+			o.StrictPathAmbiguity = true
+		})
+		assert.FalseT(t, res.IsValid())
+		errs := verifiedTestErrors(res)
+		assert.Len(t, errs, len(expected))
+		for _, msg := range expected {
+			assert.SliceContainsT(t, errs, msg)
+		}
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should not report paths for different methods or with distinct literals", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-path-ambiguities-good.yaml")
+		assert.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+}
@@ -0,0 +1,457 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/loads"
+)
+
+// Severity is the severity of a finding, e.g. of the findings of a spec rule.
+type Severity uint8
+
+// Severities, from the weakest to the strongest. The zero value is not a severity.
+const (
+	// SeverityOff disables a rule
+	SeverityOff Severity = iota + 1
+
+	// SeverityHint reports findings as [Result].Hints: suggestions, which do not affect the validity of a spec
+	SeverityHint
+
+	// SeverityInfo reports findings as [Result].Infos, which do not affect the validity of a spec
+	SeverityInfo
+
+	SeverityWarning
+	SeverityError
+)
+
+var severityNames = map[Severity]string{
+	SeverityOff:     "off",
+	SeverityHint:    "hint",
+	SeverityInfo:    "info",
+	SeverityWarning: "warning",
+	SeverityError:   "error",
+}
+
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// MarshalText renders a severity as one of off, hint, info, warning or error.
+func (s Severity) MarshalText() ([]byte, error) {
+	if _, ok := severityNames[s]; !ok {
+		return nil, fmt.Errorf("unknown severity %d: %w", s, ErrRulesConfig)
+	}
+
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText parses one of off, hint, info, warning or error.
+func (s *Severity) UnmarshalText(text []byte) error {
+	for severity, name := range severityNames {
+		if strings.EqualFold(string(text), name) {
+			*s = severity
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown severity %q, expected one of off, hint, info, warning or error: %w", text, ErrRulesConfig)
+}
+
+// RuleContext is the spec passed to a [SpecRule].
+type RuleContext struct {
+	// Document is the spec being validated
+	Document *loads.Document
+
+	// Analyzer is the analyzer of the spec, with its $ref unexpanded
+	Analyzer *analysis.Spec
+
+	// Expanded is the spec with all its $ref expanded. It is nil when the spec cannot be expanded,
+	// or when the built-in "references" rule is disabled.
+	Expanded *loads.Document
+
+	// Data is the raw spec, as unmarshalled from JSON
+	Data any
+}
+
+// SpecRule is a check carried out by the [SpecValidator] on a swagger spec.
+//
+// Built-in checks are rules as well, so they may be replaced or disabled. Custom rules are run
+// after the built-in rules of their phase (see [WithPhase]), in the order they are registered.
+type SpecRule interface {
+	// ID identifies the rule, e.g. "duplicate-operation-ids"
+	ID() string
+
+	// Severity is the highest severity of the findings of the rule. Errors reported by a rule
+	// with a warning severity are demoted to warnings.
+	Severity() Severity
+
+	// Validate checks a spec, and returns its findings as errors and warnings
+	Validate(*RuleContext) *Result
+}
+
+// RulePhase is a phase of the validation of a spec. When ContinueOnErrors is disabled, validation
+// stops at the end of a phase which reports errors.
+type RulePhase uint8
+
+// Phases of the validation of a spec, in the order they are run.
+const (
+	// PhaseSchema validates the spec against the Swagger 2.0 schema
+	PhaseSchema RulePhase = iota + 1
+
+	// PhaseReferences resolves $ref
+	PhaseReferences
+
+	// PhaseStructure checks operations, parameters, responses and definitions
+	PhaseStructure
+
+	// PhaseValues checks values, e.g. defaults and examples, and everything else
+	PhaseValues
+)
+
+// SpecRuleOption sets an optional property of a rule built with [NewSpecRule].
+type SpecRuleOption func(*specRule)
+
+// WithPhase runs a rule in some phase. By default, a rule which replaces a registered rule is run
+// in the phase of the replaced rule, and other rules in the values phase.
+func WithPhase(phase RulePhase) SpecRuleOption {
+	return func(r *specRule) {
+		r.phase = phase
+	}
+}
+
+// NewSpecRule builds a [SpecRule] from a function.
+func NewSpecRule(id string, severity Severity, validate func(*RuleContext) *Result, opts ...SpecRuleOption) SpecRule {
+	rule := &specRule{id: id, severity: severity, validate: validate}
+	for _, apply := range opts {
+		apply(rule)
+	}
+
+	return rule
+}
+
+type specRule struct {
+	id       string
+	severity Severity
+	validate func(*RuleContext) *Result
+	phase    RulePhase // zero when unspecified
+}
+
+func (r *specRule) ID() string                        { return r.id }
+func (r *specRule) Severity() Severity                { return r.severity }
+func (r *specRule) Validate(ctx *RuleContext) *Result { return r.validate(ctx) }
+
+// phaseOf returns the phase a rule is built for with [WithPhase], or zero when unspecified.
+func phaseOf(rule SpecRule) RulePhase {
+	if r, isSpecRule := rule.(*specRule); isSpecRule {
+		return r.phase
+	}
+
+	return 0
+}
+
+// registeredRule is a rule registered on a [SpecValidator].
+type registeredRule struct {
+	rule    SpecRule
+	phase   RulePhase
+	enabled bool
+}
+
+// RegisterRule registers a rule on the validator. A rule replaces the registered rule with the same ID,
+// e.g. a built-in rule, and is run in its stead. Otherwise, it is run after the rules already registered
+// in its phase.
+func (s *SpecValidator) RegisterRule(rule SpecRule) {
+	s.ensureRules()
+
+	phase := phaseOf(rule)
+	for _, registered := range s.rules {
+		if registered.rule.ID() == rule.ID() {
+			registered.rule = rule
+			if phase != 0 {
+				registered.phase = phase
+				s.sortRules()
+			}
+
+			return
+		}
+	}
+
+	s.rules = append(s.rules, &registeredRule{rule: rule, phase: cmp.Or(phase, PhaseValues), enabled: true})
+	s.sortRules()
+}
+
+// sortRules sorts the registered rules by phase, keeping the order of registration within a phase.
+func (s *SpecValidator) sortRules() {
+	slices.SortStableFunc(s.rules, func(a, b *registeredRule) int {
+		return cmp.Compare(a.phase, b.phase)
+	})
+}
+
+// EnableRule enables the rule with some ID. Rules are enabled when registered.
+//
+// It returns false when no rule with this ID is registered.
+func (s *SpecValidator) EnableRule(id string) bool {
+	return s.setRuleEnabled(id, true)
+}
+
+// DisableRule disables the rule with some ID, e.g. a built-in rule.
+//
+// It returns false when no rule with this ID is registered.
+func (s *SpecValidator) DisableRule(id string) bool {
+	return s.setRuleEnabled(id, false)
+}
+
+// Rules returns the rules registered on the validator, in the order they are run, whether they are enabled or not.
+func (s *SpecValidator) Rules() []SpecRule {
+	s.ensureRules()
+
+	rules := make([]SpecRule, 0, len(s.rules))
+	for _, registered := range s.rules {
+		rules = append(rules, registered.rule)
+	}
+
+	return rules
+}
+
+// IsRuleEnabled tells if the rule with some ID is registered and enabled.
+func (s *SpecValidator) IsRuleEnabled(id string) bool {
+	s.ensureRules()
+
+	for _, registered := range s.rules {
+		if registered.rule.ID() == id {
+			return registered.enabled
+		}
+	}
+
+	return false
+}
+
+func (s *SpecValidator) setRuleEnabled(id string, enabled bool) bool {
+	s.ensureRules()
+
+	for _, registered := range s.rules {
+		if registered.rule.ID() == id {
+			registered.enabled = enabled
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *SpecValidator) ensureRules() {
+	if s.rules != nil {
+		return
+	}
+
+	builtins := s.builtinRules()
+	s.rules = make([]*registeredRule, 0, len(builtins))
+	for _, rule := range builtins {
+		s.rules = append(s.rules, &registeredRule{rule: rule, phase: rule.phase, enabled: true})
+	}
+}
+
+// runRule runs a rule, and merges its findings according to its severity. It tells if the rule
+// returned some result, i.e. if it has been carried out.
+//
+// Findings suppressed by x-validate-ignore or recorded in the Baseline option are dropped. Findings are
+// demoted to the severity of the rule. When the severity of the rule is configured, every finding is reported
+// with the configured severity. With the WarningsAsErrors option, warnings are eventually promoted to errors.
+func (s *SpecValidator) runRule(rule SpecRule, ctx *RuleContext, settings *ruleSettings, errs *Result) bool {
+	red := rule.Validate(ctx)
+	if red == nil {
+		return false
+	}
+
+	s.suppress(rule.ID(), red)
+	s.dropBaselined(rule.ID(), red)
+
+	severityOf := func(finding error, reported Severity) Severity {
+		if limit := rule.Severity(); limit != 0 {
+			reported = min(reported, limit)
+		}
+		if settings.configures(rule.ID()) {
+			reported = settings.severityOf(rule.ID(), finding, reported)
+		}
+		if reported == SeverityWarning && s.Options.WarningsAsErrors {
+			return SeverityError
+		}
+
+		return reported
+	}
+
+	for _, severity := range []Severity{SeverityError, SeverityWarning, SeverityInfo, SeverityHint} {
+		for _, finding := range red.BySeverity(severity) {
+			reportFinding(errs, withRule(finding, rule.ID()), severityOf(finding, severity))
+		}
+	}
+
+	// keeps everything but the findings
+	red.Errors, red.Warnings, red.Infos, red.Hints = nil, nil, nil, nil
+	errs.Merge(red)
+
+	return true
+}
+
+// reportFinding adds a finding to a result, with some severity.
+func reportFinding(res *Result, finding error, severity Severity) {
+	switch severity {
+	case SeverityError:
+		res.AddErrors(finding)
+	case SeverityWarning:
+		res.AddWarnings(finding)
+	case SeverityInfo:
+		res.AddInfos(finding)
+	case SeverityHint:
+		res.AddHints(finding)
+	}
+}
+
+// builtinRules returns the checks carried out on every spec, in the order they are run.
+func (s *SpecValidator) builtinRules() []*specRule {
+	return []*specRule{
+		// Swagger schema validator
+		{id: "swagger-schema", severity: SeverityError, phase: PhaseSchema, validate: func(ctx *RuleContext) *Result {
+			schv := newSchemaValidator(s.schema, nil, "", s.KnownFormats, s.schemaOptions)
+			res := schv.Validate(ctx.Data)
+			if s.explainsKeywords() {
+				dropExplainedKeywords(res, explainedKeywords(ctx.Data, specKindRoot, ""))
+			}
+
+			return res
+		}},
+		// Explains unsupported or misplaced keywords reported by the swagger schema validator
+		{id: "keyword-placement", severity: SeverityError, phase: PhaseSchema, validate: func(ctx *RuleContext) *Result {
+			return s.validateKeywordPlacement(ctx.Data)
+		}},
+
+		// Every $ref MUST resolve. This expands the spec for the rules which follow.
+		{id: "references", severity: SeverityError, phase: PhaseReferences, validate: func(*RuleContext) *Result {
+			return s.validateReferencesValid()
+		}},
+
+		{id: "duplicate-operation-ids", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateDuplicateOperationIDs()
+		}},
+		{id: "duplicate-property-names", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateDuplicatePropertyNames()
+		}},
+		{id: "parameters", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateParameters()
+		}},
+		{id: "items", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateItems()
+		}},
+		{id: "consumes-produces", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateConsumesProduces()
+		}},
+		{id: "document-metadata", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateDocumentMetadata()
+		}},
+		{id: "responses", severity: SeverityError, phase: PhaseStructure, validate: func(ctx *RuleContext) *Result {
+			return s.validateResponses(ctx.Data)
+		}},
+		// warning, or error if StrictPathAmbiguity
+		{id: "path-ambiguities", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validatePathAmbiguities()
+		}},
+		// Properties in required definition MUST validate their schema
+		// Properties SHOULD NOT be declared as both required and readOnly (warning)
+		{id: "required-definitions", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateRequiredDefinitions()
+		}},
+		// Definitions MUST be satisfiable by a finite document
+		{id: "required-cycles", severity: SeverityError, phase: PhaseStructure, validate: func(*RuleContext) *Result {
+			return s.validateRequiredCycles()
+		}},
+
+		// Values provided as default MUST validate their schema
+		{id: "defaults", severity: SeverityError, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			df := &defaultValidator{SpecValidator: s, schemaOptions: s.schemaOptions}
+			return df.Validate()
+		}},
+		// Values provided in enums MUST validate their schema and MUST be unique
+		{id: "enums", severity: SeverityError, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			en := &enumValidator{SpecValidator: s, schemaOptions: s.schemaOptions}
+			return en.Validate()
+		}},
+		// Values provided as examples MUST validate their schema
+		// Value provided as examples in a response without schema generate a warning
+		// Examples in responses are decoded according to their media type: examples for media types other than
+		// JSON, YAML, XML or plain text are not validated (warning)
+		{id: "examples", severity: SeverityError, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			ex := &exampleValidator{SpecValidator: s, schemaOptions: s.schemaOptions}
+			return ex.Validate()
+		}},
+		{id: "path-param-names", severity: SeverityError, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			return s.validateNonEmptyPathParamNames()
+		}},
+		// Vendor extensions MUST validate their registered schema
+		{id: "vendor-extensions", severity: SeverityError, phase: PhaseValues, validate: func(ctx *RuleContext) *Result {
+			return s.validateVendorExtensions(ctx.Data)
+		}},
+		// Constructs which break code generation with go-swagger
+		{id: "go-swagger", severity: SeverityError, phase: PhaseValues, validate: func(ctx *RuleContext) *Result {
+			if !s.Options.GoSwaggerMode {
+				return nil
+			}
+			gs := &goSwaggerValidator{SpecValidator: s}
+			return gs.Validate(ctx.Data)
+		}},
+		// warning, or error if StrictRefSiblings
+		{id: "ref-siblings", severity: SeverityError, phase: PhaseValues, validate: func(ctx *RuleContext) *Result {
+			return s.validateRefNoSibling(ctx.Data)
+		}},
+		{id: "unused-references", severity: SeverityWarning, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			return s.validateReferenced()
+		}},
+		{id: "dubious-refs", severity: SeverityWarning, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			return s.validateDubiousRefs()
+		}},
+		// Definitions which are structurally identical
+		{id: "duplicate-definitions", severity: SeverityWarning, phase: PhaseValues, validate: func(ctx *RuleContext) *Result {
+			if !s.Options.DuplicateDefinitions {
+				return nil
+			}
+			return s.validateDuplicateDefinitions(ctx.Data)
+		}},
+		{id: "security-lint", severity: SeverityWarning, phase: PhaseValues, validate: func(ctx *RuleContext) *Result {
+			if !s.Options.SecurityLint {
+				return nil
+			}
+			return s.validateSecurityLint(ctx.Data)
+		}},
+		{id: "unbounded-resources", severity: SeverityWarning, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			if s.Options.UnboundedResourceLint == 0 {
+				return nil
+			}
+			ub := &unboundedValidator{SpecValidator: s}
+			return ub.Validate()
+		}},
+		{id: "conventions", severity: SeverityWarning, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			if s.Options.Conventions == nil {
+				return nil
+			}
+			return s.validateConventions()
+		}},
+		{id: "documentation", severity: SeverityWarning, phase: PhaseValues, validate: func(*RuleContext) *Result {
+			if !s.Options.DocumentationLint {
+				return nil
+			}
+			dc := &documentationValidator{SpecValidator: s}
+			return dc.Validate()
+		}},
+	}
+}
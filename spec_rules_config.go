@@ -0,0 +1,383 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	yaml "go.yaml.in/yaml/v3"
+)
+
+type rulesConfigError string
+
+func (e rulesConfigError) Error() string {
+	return string(e)
+}
+
+// ErrRulesConfig indicates an invalid rules configuration.
+const ErrRulesConfig rulesConfigError = "invalid rules configuration"
+
+// RulesConfig tunes the rules of the spec validator for a repository. It is usually loaded
+// from a YAML or JSON file with [LoadRulesConfig], then applied to [Opts] with [RulesConfig.ApplyTo]:
+//
+//	rules:
+//	  security-lint: warning          # enables an optional rule
+//	  examples: off                   # disables a rule
+//	  unused-references: info         # changes the severity of a rule
+//	  conventions:
+//	    severity: warning
+//	    params:
+//	      operationIds: camelCase
+//	      properties: snake_case
+//	overrides:
+//	  - paths: ["/internal/**"]
+//	    definitions: ["Internal*"]
+//	    rules:
+//	      security-lint: off
+type RulesConfig struct {
+	// Rules configures rules by ID
+	Rules map[string]RuleConfig `json:"rules,omitempty" yaml:"rules,omitempty"`
+
+	// Overrides change the severity of the findings about some paths or definitions. When several
+	// overrides apply to a finding, the last one wins.
+	Overrides []RuleOverride `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+}
+
+// RuleConfig configures a rule. In a file, a rule may be configured with its severity only, e.g. "examples: off".
+type RuleConfig struct {
+	// Severity overrides the default severity of the rule. Findings are all reported with this severity.
+	Severity Severity `json:"severity,omitempty" yaml:"severity,omitempty"`
+
+	// Params are the parameters of the rule, e.g. the naming styles of the conventions rule
+	Params map[string]any `json:"params,omitempty" yaml:"params,omitempty"`
+}
+
+// UnmarshalYAML accepts either a severity or a mapping with a severity and params.
+func (c *RuleConfig) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&c.Severity)
+	}
+
+	type plain RuleConfig
+
+	return node.Decode((*plain)(c))
+}
+
+// RuleOverride changes the severity of the findings of some rules about the paths or the definitions
+// matching some glob patterns.
+//
+// In patterns, * matches any sequence of characters but /, ** matches any sequence of characters,
+// and ? matches any character but /.
+//
+// A finding is about a path when it is located at this path or below it, e.g. at one of its operations,
+// and about a definition when it is located at this definition or below it (see [FindingAt]).
+type RuleOverride struct {
+	Paths       []string            `json:"paths,omitempty" yaml:"paths,omitempty"`
+	Definitions []string            `json:"definitions,omitempty" yaml:"definitions,omitempty"`
+	Rules       map[string]Severity `json:"rules" yaml:"rules"`
+}
+
+// ParseRulesConfig parses a YAML or JSON rules configuration.
+func ParseRulesConfig(data []byte) (*RulesConfig, error) {
+	var config RulesConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRulesConfig, err)
+	}
+
+	for i, override := range config.Overrides {
+		for _, pattern := range slices.Concat(override.Paths, override.Definitions) {
+			if _, err := globRegexp(pattern); err != nil {
+				return nil, fmt.Errorf("invalid glob pattern %q in override %d: %w", pattern, i, ErrRulesConfig)
+			}
+		}
+	}
+
+	return &config, nil
+}
+
+// LoadRulesConfig loads a YAML or JSON rules configuration file.
+func LoadRulesConfig(path string) (*RulesConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRulesConfig, err)
+	}
+
+	return ParseRulesConfig(data)
+}
+
+// ApplyTo sets the Rules option, and the options of the built-in rules which are configured:
+//
+//   - optional rules (go-swagger, duplicate-definitions, security-lint, unbounded-resources, conventions
+//     and documentation) are enabled unless their severity is off
+//   - rule params are set, e.g. the naming styles of the conventions rule. Params are merged into the
+//     conventions already set, if any
+func (c *RulesConfig) ApplyTo(o *Opts) error {
+	for _, id := range slices.Sorted(maps.Keys(c.Rules)) {
+		rule := c.Rules[id]
+		enabled := rule.Severity != SeverityOff
+
+		switch id {
+		case "go-swagger":
+			o.GoSwaggerMode = enabled
+		case "security-lint":
+			o.SecurityLint = enabled
+		case "documentation":
+			o.DocumentationLint = enabled
+		case "duplicate-definitions":
+			o.DuplicateDefinitions = enabled
+		case "unbounded-resources":
+			o.UnboundedResourceLint = 0
+			if enabled {
+				o.UnboundedResourceLint = UnboundedEverywhere
+			}
+		case "conventions":
+			switch {
+			case !enabled:
+				o.Conventions = nil
+			case o.Conventions == nil:
+				o.Conventions = DefaultConventions()
+			}
+		}
+
+		if err := applyRuleParams(id, rule.Params, o); err != nil {
+			return err
+		}
+	}
+
+	o.Rules = c
+
+	return nil
+}
+
+// applyRuleParams sets the options of a built-in rule from its params.
+func applyRuleParams(id string, params map[string]any, o *Opts) error {
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		value := params[name]
+
+		var err error
+		switch {
+		case id == "conventions" && o.Conventions != nil:
+			err = applyConventionsParam(o.Conventions, name, value)
+		case id == "unbounded-resources" && name == "scope":
+			err = applyUnboundedScopeParam(o, value)
+		case id == "duplicate-definitions" && name == "ignoreDocs":
+			err = boolParam(&o.DuplicateDefinitionsIgnoreDocs, value)
+		case id == "path-ambiguities" && name == "strict":
+			err = boolParam(&o.StrictPathAmbiguity, value)
+		case id == "ref-siblings" && name == "strict":
+			err = boolParam(&o.StrictRefSiblings, value)
+		case id == "ref-siblings" && name == "ignoreExtensions":
+			err = boolParam(&o.IgnoreRefSiblingExtensions, value)
+		case id == "vendor-extensions" && name == "strict":
+			err = boolParam(&o.StrictExtensions, value)
+		default:
+			return fmt.Errorf("unknown param %q for rule %q: %w", name, id, ErrRulesConfig)
+		}
+
+		if err != nil {
+			return fmt.Errorf("param %q for rule %q: %w", name, id, err)
+		}
+	}
+
+	return nil
+}
+
+func applyConventionsParam(conventions *Conventions, name string, value any) error {
+	styles := map[string]*NamingStyle{
+		"operationIds": &conventions.OperationIDs,
+		"definitions":  &conventions.Definitions,
+		"properties":   &conventions.Properties,
+		"pathSegments": &conventions.PathSegments,
+	}
+	if style, isStyle := styles[name]; isStyle {
+		text, isString := value.(string)
+		if !isString {
+			return fmt.Errorf("expected a naming style: %w", ErrRulesConfig)
+		}
+		if text != string(ConsistentCase) && !slices.ContainsFunc(namingPatterns, func(naming namingPattern) bool {
+			return string(naming.style) == text
+		}) {
+			return fmt.Errorf("unknown naming style %q: %w", text, ErrRulesConfig)
+		}
+		*style = NamingStyle(text)
+
+		return nil
+	}
+
+	switch name {
+	case "declaredTags":
+		return boolParam(&conventions.DeclaredTags, value)
+	case "uniqueTags":
+		return boolParam(&conventions.UniqueTags, value)
+	default:
+		return fmt.Errorf("unknown param: %w", ErrRulesConfig)
+	}
+}
+
+func applyUnboundedScopeParam(o *Opts, value any) error {
+	scopes := map[string]UnboundedScope{
+		"requests":   UnboundedInRequests,
+		"responses":  UnboundedInResponses,
+		"everywhere": UnboundedEverywhere,
+	}
+
+	text, _ := value.(string)
+	scope, isScope := scopes[text]
+	if !isScope {
+		return fmt.Errorf("expected one of requests, responses or everywhere: %w", ErrRulesConfig)
+	}
+	o.UnboundedResourceLint = scope
+
+	return nil
+}
+
+func boolParam(target *bool, value any) error {
+	b, isBool := value.(bool)
+	if !isBool {
+		return fmt.Errorf("expected a boolean: %w", ErrRulesConfig)
+	}
+	*target = b
+
+	return nil
+}
+
+// globRegexp compiles a glob pattern on paths or definitions.
+func globRegexp(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '*' && i+1 < len(pattern) && pattern[i+1] == '*':
+			expr.WriteString(".*")
+			i++
+		case c == '*':
+			expr.WriteString("[^/]*")
+		case c == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+
+	return regexp.Compile(expr.String())
+}
+
+// ruleSettings are the severities configured for the rules, when validating a spec.
+type ruleSettings struct {
+	config *RulesConfig
+
+	// globs lists, for every override, the compiled patterns on paths and definitions
+	globs []overrideGlobs
+}
+
+type overrideGlobs struct {
+	paths       []*regexp.Regexp
+	definitions []*regexp.Regexp
+}
+
+// ruleSettings compiles the overrides of the Rules option.
+func (s *SpecValidator) ruleSettings() *ruleSettings {
+	config := s.Options.Rules
+	if config == nil {
+		return &ruleSettings{}
+	}
+
+	compile := func(patterns []string) []*regexp.Regexp {
+		globs := make([]*regexp.Regexp, 0, len(patterns))
+		for _, pattern := range patterns {
+			if glob, err := globRegexp(pattern); err == nil {
+				globs = append(globs, glob)
+			}
+		}
+
+		return globs
+	}
+
+	settings := &ruleSettings{config: config, globs: make([]overrideGlobs, len(config.Overrides))}
+	for i, override := range config.Overrides {
+		settings.globs[i] = overrideGlobs{paths: compile(override.Paths), definitions: compile(override.Definitions)}
+	}
+
+	return settings
+}
+
+// matches tells if a finding at some location is about a path or a definition matching the globs
+// of an override. Findings which are not located do not match any override.
+func (g overrideGlobs) matches(location string) bool {
+	tokens := strings.SplitN(strings.TrimPrefix(location, "#/"), "/", 3)
+	if !strings.HasPrefix(location, "#/") || len(tokens) < 2 {
+		return false
+	}
+
+	var globs []*regexp.Regexp
+	switch tokens[0] {
+	case "paths":
+		globs = g.paths
+	case "definitions":
+		globs = g.definitions
+	}
+
+	name := jsonpointer.Unescape(tokens[1])
+
+	return slices.ContainsFunc(globs, func(glob *regexp.Regexp) bool {
+		return glob.MatchString(name)
+	})
+}
+
+// configures tells if the severity of a rule is configured, globally or in some override.
+func (r *ruleSettings) configures(id string) bool {
+	if r.config == nil {
+		return false
+	}
+
+	if rule, isConfigured := r.config.Rules[id]; isConfigured && rule.Severity != 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(r.config.Overrides, func(override RuleOverride) bool {
+		_, isConfigured := override.Rules[id]
+		return isConfigured
+	})
+}
+
+// isOff tells if a rule is disabled, without any override enabling it for some paths or definitions.
+func (r *ruleSettings) isOff(id string) bool {
+	if r.config == nil || r.config.Rules[id].Severity != SeverityOff {
+		return false
+	}
+
+	return !slices.ContainsFunc(r.config.Overrides, func(override RuleOverride) bool {
+		severity, isConfigured := override.Rules[id]
+		return isConfigured && severity != SeverityOff
+	})
+}
+
+// severityOf returns the severity of a finding of a rule: the severity of the last override
+// about the finding, the configured severity of the rule, or the severity the rule reported.
+func (r *ruleSettings) severityOf(id string, finding error, reported Severity) Severity {
+	location := locationOf(finding)
+	for i := len(r.config.Overrides) - 1; i >= 0; i-- {
+		severity, isConfigured := r.config.Overrides[i].Rules[id]
+		if !isConfigured || severity == 0 {
+			continue
+		}
+
+		if r.globs[i].matches(location) {
+			return severity
+		}
+	}
+
+	if severity := r.config.Rules[id].Severity; severity != 0 {
+		return severity
+	}
+
+	return reported
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestParseRulesConfig(t *testing.T) {
+	t.Run("should parse a YAML configuration", func(t *testing.T) {
+		config, err := ParseRulesConfig([]byte(`
+rules:
+  examples: off
+  unused-references: info
+  dubious-refs: hint
+  conventions:
+    severity: warning
+    params:
+      operationIds: PascalCase
+overrides:
+  - paths: ["/internal/**"]
+    rules:
+      security-lint: error
+`))
+		require.NoError(t, err)
+
+		assert.EqualT(t, SeverityOff, config.Rules["examples"].Severity)
+		assert.EqualT(t, SeverityInfo, config.Rules["unused-references"].Severity)
+		assert.EqualT(t, SeverityHint, config.Rules["dubious-refs"].Severity)
+		assert.EqualT(t, SeverityWarning, config.Rules["conventions"].Severity)
+		assert.Equal(t, map[string]any{"operationIds": "PascalCase"}, config.Rules["conventions"].Params)
+		require.Len(t, config.Overrides, 1)
+		assert.Equal(t, []string{"/internal/**"}, config.Overrides[0].Paths)
+		assert.Equal(t, map[string]Severity{"security-lint": SeverityError}, config.Overrides[0].Rules)
+	})
+
+	t.Run("should parse a JSON configuration", func(t *testing.T) {
+		config, err := ParseRulesConfig([]byte(`{"rules": {"parameters": "warning", "conventions": {"params": {"uniqueTags": true}}}}`))
+		require.NoError(t, err)
+
+		assert.EqualT(t, SeverityWarning, config.Rules["parameters"].Severity)
+		assert.Equal(t, map[string]any{"uniqueTags": true}, config.Rules["conventions"].Params)
+	})
+
+	t.Run("should load a configuration file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rules.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("rules:\n  security-lint: warning\n"), 0o600))
+
+		config, err := LoadRulesConfig(path)
+		require.NoError(t, err)
+		assert.EqualT(t, SeverityWarning, config.Rules["security-lint"].Severity)
+
+		_, err = LoadRulesConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.ErrorIs(t, err, ErrRulesConfig)
+	})
+
+	t.Run("should reject an unknown severity", func(t *testing.T) {
+		_, err := ParseRulesConfig([]byte("rules:\n  examples: fatal\n"))
+		require.ErrorIs(t, err, ErrRulesConfig)
+	})
+
+	t.Run("should reject unknown params", func(t *testing.T) {
+		for _, doc := range []string{
+			"rules:\n  parameters:\n    params:\n      strict: true\n",
+			"rules:\n  conventions:\n    params:\n      operationIds: SCREAMING_CASE\n",
+			"rules:\n  unbounded-resources:\n    params:\n      scope: nowhere\n",
+			"rules:\n  path-ambiguities:\n    params:\n      strict: yes please\n",
+		} {
+			config, err := ParseRulesConfig([]byte(doc))
+			require.NoError(t, err)
+			require.ErrorIs(t, config.ApplyTo(new(Opts)), ErrRulesConfig, doc)
+		}
+	})
+}
+
+func TestRulesConfig_ApplyTo(t *testing.T) {
+	config, err := ParseRulesConfig([]byte(`
+rules:
+  security-lint: warning
+  documentation: off
+  conventions:
+    params:
+      properties: snake_case
+      uniqueTags: false
+  unbounded-resources:
+    params:
+      scope: requests
+  path-ambiguities:
+    params:
+      strict: true
+`))
+	require.NoError(t, err)
+
+	opts := Opts{DocumentationLint: true}
+	require.NoError(t, config.ApplyTo(&opts))
+
+	assert.TrueT(t, opts.SecurityLint)
+	assert.FalseT(t, opts.DocumentationLint)
+	assert.TrueT(t, opts.StrictPathAmbiguity)
+	assert.EqualT(t, UnboundedInRequests, opts.UnboundedResourceLint)
+	require.NotNil(t, opts.Conventions)
+	assert.EqualT(t, SnakeCase, opts.Conventions.Properties)
+	assert.EqualT(t, CamelCase, opts.Conventions.OperationIDs)
+	assert.FalseT(t, opts.Conventions.UniqueTags)
+	assert.Equal(t, config, opts.Rules)
+
+	t.Run("should merge params into the conventions already set", func(t *testing.T) {
+		opts := Opts{Conventions: &Conventions{Definitions: PascalCase}}
+		require.NoError(t, config.ApplyTo(&opts))
+
+		require.NotNil(t, opts.Conventions)
+		assert.EqualT(t, PascalCase, opts.Conventions.Definitions)
+		assert.EqualT(t, SnakeCase, opts.Conventions.Properties)
+		assert.EqualT(t, NamingStyle(""), opts.Conventions.OperationIDs)
+	})
+}
+
+func TestGlobRegexp(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, value string
+		expected       bool
+	}{
+		{"/internal/**", "/internal/users/{id}", true},
+		{"/internal/*", "/internal/users", true},
+		{"/internal/*", "/internal/users/{id}", false},
+		{"/users/?", "/users/1", true},
+		{"Internal*", "InternalUser", true},
+		{"Internal*", "User", false},
+		{"/v1.0/*", "/v1x0/users", false},
+	} {
+		glob, err := globRegexp(tc.pattern)
+		require.NoError(t, err)
+		assert.EqualT(t, tc.expected, glob.MatchString(tc.value), "%s ~ %s", tc.pattern, tc.value)
+	}
+}
+
+func TestSpec_ValidateWithRulesConfig(t *testing.T) {
+	fixture := filepath.Join("fixtures", "validation", "fixture-rules-config.yaml")
+
+	validate := func(t *testing.T, rules string) *Result {
+		t.Helper()
+
+		config, err := ParseRulesConfig([]byte(rules))
+		require.NoError(t, err)
+
+		document, err := loads.Spec(fixture)
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		require.NoError(t, config.ApplyTo(&validator.Options))
+		res, _ := validator.Validate(document)
+
+		return res
+	}
+
+	t.Run("should disable a rule", func(t *testing.T) {
+		res := validate(t, "rules:\n  duplicate-operation-ids: off\n  unused-references: off\n")
+		assert.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should change the severity of a rule", func(t *testing.T) {
+		res := validate(t, "rules:\n  duplicate-operation-ids: warning\n  unused-references: info\n")
+		assert.TrueT(t, res.IsValid())
+		assert.SliceContainsT(t, verifiedTestWarnings(res), `"getUsers" is defined 2 times`)
+		assert.Len(t, res.Warnings, 1)
+		assert.Len(t, res.Infos, 2)
+	})
+
+	t.Run("should scope overrides to paths and definitions", func(t *testing.T) {
+		res := validate(t, `
+rules:
+  security-lint: warning
+overrides:
+  - paths: ["/internal/**"]
+    definitions: ["Internal*"]
+    rules:
+      security-lint: off
+      unused-references: error
+`)
+		warnings := verifiedTestWarnings(res)
+		assert.SliceContainsT(t, warnings, `operation "getUsers" has no security requirement`)
+		assert.SliceNotContainsT(t, warnings, `operation "getInternalUsers" has no security requirement`)
+		assert.SliceContainsT(t, warnings, `definition "#/definitions/User" is not used anywhere`)
+
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs, `definition "#/definitions/InternalUser" is not used anywhere`)
+	})
+
+	t.Run("should anchor overrides on the location of findings", func(t *testing.T) {
+		res := validate(t, `
+overrides:
+  - paths: ["/users"]
+    definitions: ["User"]
+    rules:
+      duplicate-operation-ids: warning
+      unused-references: error
+`)
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs, `definition "#/definitions/User" is not used anywhere`)
+		assert.SliceNotContainsT(t, errs, `definition "#/definitions/InternalUser" is not used anywhere`)
+
+		// duplicate operationIds are located at the first operation, under /groups
+		assert.SliceContainsT(t, errs, `"getUsers" is defined 2 times`)
+	})
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_Rules(t *testing.T) {
+	fixture := filepath.Join("fixtures", "validation", "fixture-rules.yaml")
+	const duplicateOperationID = `"getUsers" is defined 2 times`
+
+	validate := func(t *testing.T, configure func(*SpecValidator)) *Result {
+		t.Helper()
+
+		document, err := loads.Spec(fixture)
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		configure(validator)
+		res, _ := validator.Validate(document)
+
+		return res
+	}
+
+	noOperationSummary := NewSpecRule("operation-summary", SeverityWarning, func(ctx *RuleContext) *Result {
+		res := new(Result)
+		for _, op := range ctx.Analyzer.Operations()["GET"] {
+			if op.Summary == "" {
+				res.AddErrors(errors.New(errors.CompositeErrorCode, "operation %q has no summary", op.ID))
+			}
+		}
+
+		return res
+	})
+
+	t.Run("should register built-in rules", func(t *testing.T) {
+		validator := NewSpecValidator(nil, strfmt.Default)
+
+		ids := make([]string, 0, len(validator.Rules()))
+		for _, rule := range validator.Rules() {
+			ids = append(ids, rule.ID())
+		}
+		assert.EqualT(t, "swagger-schema", ids[0])
+		assert.SliceContainsT(t, ids, "duplicate-operation-ids")
+		assert.SliceContainsT(t, ids, "parameters")
+		assert.TrueT(t, validator.IsRuleEnabled("parameters"))
+		assert.FalseT(t, validator.IsRuleEnabled("unknown"))
+	})
+
+	t.Run("should run built-in rules", func(t *testing.T) {
+		res := validate(t, func(*SpecValidator) {})
+		assert.SliceContainsT(t, verifiedTestErrors(res), duplicateOperationID)
+	})
+
+	t.Run("should disable a built-in rule", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			require.TrueT(t, validator.DisableRule("duplicate-operation-ids"))
+			require.FalseT(t, validator.DisableRule("unknown"))
+		})
+		assert.TrueT(t, res.IsValid())
+	})
+
+	t.Run("should run a custom rule with its severity", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			validator.RegisterRule(noOperationSummary)
+		})
+		assert.SliceContainsT(t, verifiedTestErrors(res), duplicateOperationID)
+		assert.SliceContainsT(t, verifiedTestWarnings(res), `operation "getUsers" has no summary`)
+	})
+
+	t.Run("should disable and enable a custom rule", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			validator.RegisterRule(noOperationSummary)
+			require.TrueT(t, validator.DisableRule("operation-summary"))
+		})
+		assert.Empty(t, res.Warnings)
+
+		res = validate(t, func(validator *SpecValidator) {
+			validator.RegisterRule(noOperationSummary)
+			validator.DisableRule("operation-summary")
+			require.TrueT(t, validator.EnableRule("operation-summary"))
+		})
+		assert.Len(t, res.Warnings, 1)
+	})
+
+	t.Run("should replace a built-in rule", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			validator.RegisterRule(NewSpecRule("duplicate-operation-ids", SeverityError, func(*RuleContext) *Result {
+				return nil
+			}))
+		})
+		assert.TrueT(t, res.IsValid())
+	})
+
+	t.Run("should stop at the end of the phase which reports errors", func(t *testing.T) {
+		var called bool
+		document, err := loads.Spec(fixture)
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.RegisterRule(NewSpecRule("called", SeverityError, func(*RuleContext) *Result {
+			called = true
+
+			return nil
+		}))
+		res, _ := validator.Validate(document)
+		assert.FalseT(t, res.IsValid())
+		assert.FalseT(t, called)
+	})
+
+	t.Run("should run a rule replacing a built-in rule in the phase of the built-in rule", func(t *testing.T) {
+		document, err := loads.Spec(fixture)
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.RegisterRule(NewSpecRule("swagger-schema", SeverityError, func(*RuleContext) *Result {
+			res := new(Result)
+			res.AddErrors(errors.New(errors.CompositeErrorCode, "invalid spec"))
+
+			return res
+		}))
+		res, _ := validator.Validate(document)
+		assert.Equal(t, []string{"invalid spec"}, verifiedTestErrors(res))
+	})
+
+	t.Run("should run a custom rule in its phase", func(t *testing.T) {
+		document, err := loads.Spec(fixture)
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.RegisterRule(NewSpecRule("early", SeverityError, func(*RuleContext) *Result {
+			res := new(Result)
+			res.AddErrors(errors.New(errors.CompositeErrorCode, "invalid spec"))
+
+			return res
+		}, WithPhase(PhaseReferences)))
+		require.EqualT(t, "early", validator.Rules()[3].ID())
+
+		res, _ := validator.Validate(document)
+		assert.Equal(t, []string{"invalid spec"}, verifiedTestErrors(res))
+	})
+
+	t.Run("should attribute the same finding to every rule reporting it", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			validator.RegisterRule(noOperationSummary)
+			validator.RegisterRule(NewSpecRule("operation-docs", SeverityWarning, noOperationSummary.Validate))
+		})
+
+		var rules []string
+		for _, finding := range res.Findings() {
+			if finding.Message == `operation "getUsers" has no summary` {
+				rules = append(rules, finding.Rule)
+			}
+		}
+		assert.Equal(t, []string{"operation-summary", "operation-docs"}, rules)
+	})
+
+	t.Run("should report findings with the severity of a custom rule", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			validator.RegisterRule(NewSpecRule("operation-summary", SeverityHint, noOperationSummary.Validate))
+		})
+		assert.Empty(t, res.Warnings)
+		require.Len(t, res.BySeverity(SeverityHint), 1)
+		assert.EqualT(t, `operation "getUsers" has no summary`, res.Hints[0].Error())
+	})
+
+	t.Run("should report infos and hints along with the warnings", func(t *testing.T) {
+		document, err := loads.Spec(fixture)
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		validator.RegisterRule(NewSpecRule("operation-summary", SeverityInfo, noOperationSummary.Validate))
+		validator.RegisterRule(NewSpecRule("operation-docs", SeverityHint, noOperationSummary.Validate))
+		_, warnings := validator.Validate(document)
+
+		require.Len(t, warnings.Infos, 1)
+		require.Len(t, warnings.Hints, 1)
+		assert.EqualT(t, `operation "getUsers" has no summary`, warnings.Infos[0].Error())
+		assert.EqualT(t, `operation "getUsers" has no summary`, warnings.Hints[0].Error())
+	})
+
+	t.Run("should promote warnings to errors", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			validator.RegisterRule(noOperationSummary)
+			validator.Options.WarningsAsErrors = true
+		})
+		assert.Empty(t, res.Warnings)
+		assert.SliceContainsT(t, verifiedTestErrors(res), `operation "getUsers" has no summary`)
+	})
+
+	t.Run("should fail at the failure threshold", func(t *testing.T) {
+		res := validate(t, func(validator *SpecValidator) {
+			validator.DisableRule("duplicate-operation-ids")
+			validator.RegisterRule(noOperationSummary)
+		})
+		assert.TrueT(t, res.IsValid())
+		require.NoError(t, res.AsError())
+
+		res = validate(t, func(validator *SpecValidator) {
+			validator.DisableRule("duplicate-operation-ids")
+			validator.RegisterRule(noOperationSummary)
+			validator.Options.FailureThreshold = SeverityWarning
+		})
+		assert.TrueT(t, res.IsValid())
+		require.Error(t, res.AsError())
+		assert.StringContainsT(t, res.AsError().Error(), `operation "getUsers" has no summary`)
+	})
+}
+
+func TestSpec_FailureThreshold(t *testing.T) {
+	document, err := loads.Spec(filepath.Join("fixtures", "validation", "fixture-failure-threshold.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, Spec(document, strfmt.Default))
+
+	err = SpecWithOpts(document, strfmt.Default, func(opts *Opts) {
+		opts.FailureThreshold = SeverityWarning
+	})
+	require.Error(t, err)
+	assert.StringContainsT(t, err.Error(), `definition "#/definitions/User" is not used anywhere`)
+
+	validator := NewSpecValidator(document.Schema(), strfmt.Default)
+	validator.Options.FailureThreshold = SeverityWarning
+	res, _ := validator.Validate(document)
+	assert.TrueT(t, res.IsValid())
+
+	err = res.AsError()
+	require.Error(t, err)
+	assert.StringContainsT(t, err.Error(), `definition "#/definitions/User" is not used anywhere`)
+}
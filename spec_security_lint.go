@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+// validateSecurityLint reports common API security smells as warnings. It is enabled by the SecurityLint option.
+//
+//   - operations without any security requirement
+//   - API keys sent in the query string
+//   - the OAuth2 implicit flow
+//   - password fields returned in responses
+//   - readOnly fields accepted in request bodies
+//
+// Schemes listing only insecure schemes (http or ws) are reported when validating the document metadata.
+//
+// Request and response bodies are inspected on the raw document, following $ref so that every schema is inspected once.
+func (s *SpecValidator) validateSecurityLint(doc any) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	sw := s.spec.Spec()
+
+	for _, name := range slices.Sorted(maps.Keys(sw.SecurityDefinitions)) {
+		scheme := sw.SecurityDefinitions[name]
+		pointer := "/securityDefinitions/" + jsonpointer.Escape(name)
+		switch {
+		case scheme.Type == "apiKey" && scheme.In == "query":
+			res.AddWarnings(FindingAt(pointer, securityAPIKeyInQueryMsg(name)))
+		case scheme.Type == "oauth2" && scheme.Flow == "implicit":
+			res.AddWarnings(FindingAt(pointer, securityOAuth2ImplicitMsg(name)))
+		}
+	}
+
+	paths := asObject(asObject(doc)["paths"])
+	for method, pi := range s.analyzer.Operations() {
+		for path, op := range pi {
+			operation := op.ID
+			if operation == "" {
+				operation = fmt.Sprintf("%s %s", method, path)
+			}
+
+			security := op.Security
+			if security == nil {
+				security = sw.Security
+			}
+			if len(security) == 0 {
+				res.AddWarnings(FindingAt(operationPointer(method, path), securityNoRequirementMsg(operation)))
+			}
+
+			pathItem := asObject(paths[path])
+			object := asObject(pathItem[strings.ToLower(method)])
+
+			if name, schema, found := bodyParamSchema(doc, pathItem, object); found {
+				pointer := s.paramPointer(method, path, spec.BodyParam(name, nil))
+				walkLintProperties(doc, schema, func(property string, schema map[string]any) {
+					if readOnly, _ := schema["readOnly"].(bool); readOnly {
+						res.AddWarnings(FindingAt(pointer, securityReadOnlyInRequestMsg(operation, name, property)))
+					}
+				})
+			}
+
+			responses := asObject(object["responses"])
+			for _, code := range sortedKeys(responses) {
+				if isExtension(code) {
+					continue
+				}
+
+				name := "response " + code
+				if code == jsonDefault {
+					name = "default response"
+				}
+
+				// unresolved references are reported elsewhere
+				response, _ := resolveLocalRef(doc, responses[code])
+				pointer := operationPointer(method, path) + "/responses/" + jsonpointer.Escape(code)
+				walkLintProperties(doc, asObject(response)["schema"], func(property string, schema map[string]any) {
+					if schema["format"] == stringFormatPassword {
+						res.AddWarnings(FindingAt(pointer, securityPasswordInResponseMsg(operation, name, property)))
+					}
+				})
+			}
+		}
+	}
+
+	return res
+}
+
+// bodyParamSchema returns the name and the raw schema of the body parameter of an operation, declared on
+// the operation or on its path item.
+func bodyParamSchema(doc any, pathItem, operation map[string]any) (string, any, bool) {
+	for _, params := range [][]any{asArray(operation["parameters"]), asArray(pathItem["parameters"])} {
+		for _, param := range params {
+			// unresolved references are reported elsewhere
+			resolved, _ := resolveLocalRef(doc, param)
+			if object := asObject(resolved); object["in"] == swaggerBody {
+				name, _ := object["name"].(string)
+
+				return name, object["schema"], true
+			}
+		}
+	}
+
+	return "", nil, false
+}
+
+// walkLintProperties calls visit for every property of a raw schema, with its dotted path, e.g. "credentials.password".
+//
+// $ref within the spec are followed, and every schema referred to by a $ref is visited once.
+func walkLintProperties(doc, schema any, visit func(property string, schema map[string]any)) {
+	visited := make(map[string]bool)
+
+	var walk func(node any, pointer string)
+	walk = func(node any, pointer string) {
+		walkSpec(node, pointer, specKindSchema, func(pointer string, _ specKind, object map[string]any) bool {
+			if ref, isRef := object["$ref"].(string); isRef {
+				if !visited[ref] {
+					visited[ref] = true
+					if target, ok := resolveLocalRef(doc, object); ok {
+						walk(target, pointer)
+					}
+				}
+
+				return false
+			}
+
+			if property, isProperty := propertyPath(pointer); isProperty {
+				visit(property, object)
+			}
+
+			return true
+		})
+	}
+	walk(schema, "")
+}
+
+// propertyPath returns the dotted path of the properties found along the JSON pointer to a schema,
+// and tells if the schema is itself a property.
+func propertyPath(pointer string) (string, bool) {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+
+	var names []string
+	isProperty := false
+	for i := 0; i < len(tokens); i++ {
+		isProperty = tokens[i] == jsonProperties && i+1 < len(tokens)
+		if isProperty {
+			i++
+			names = append(names, jsonpointer.Unescape(tokens[i]))
+		}
+	}
+
+	return strings.Join(names, "."), isProperty
+}
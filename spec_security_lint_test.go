@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_ValidateSecurityLint(t *testing.T) {
+	t.Run("should not lint security by default", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-security-lint.yaml")
+		require.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should warn about security smells", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-security-lint.yaml", func(o *Opts) { o.SecurityLint = true })
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`security definition "key" sends an API key in the query string, where it may be logged: prefer a header`,
+			`security definition "oauth" uses the OAuth2 implicit flow, which exposes tokens: prefer the accessCode flow`,
+			`operation "getUsers" has no security requirement`,
+			`in operation "getUsers", response 200 returns the password field credentials.password`,
+			`in operation "login", response 200 returns the password field password`,
+			`in operation "addUser", body param "user" accepts the readOnly field id`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		// http is listed next to https: insecure schemes are not reported.
+		// Recursive schemas are inspected once: manager.id is not reported again.
+		assert.Len(t, warnings, len(expected))
+	})
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"slices"
+	"strings"
+)
+
+// xValidateIgnore lists the IDs of the rules which findings are suppressed on an object of the spec
+// and below, e.g. "x-validate-ignore": ["unused-references"].
+const xValidateIgnore = "x-validate-ignore"
+
+// suppression is a rule suppressed by x-validate-ignore on an object of the spec.
+type suppression struct {
+	pointer string // JSON pointer to the object declaring the suppression
+	ruleID  string
+
+	// used tells if the suppression matched some finding
+	used bool
+}
+
+// suppressionsOf collects the rules suppressed by x-validate-ignore in a raw swagger document.
+//
+// Extensions which are not a list of rule IDs are reported as warnings.
+func (s *SpecValidator) suppressionsOf(doc any, res *Result) []*suppression {
+	var suppressions []*suppression
+
+	walkExtensions(doc, func(pointer string, _ ExtensionKind, name string, value any) {
+		if !strings.EqualFold(name, xValidateIgnore) {
+			return
+		}
+
+		var ids []string
+		switch typed := value.(type) {
+		case string:
+			ids = []string{typed}
+		case []any:
+			for _, item := range typed {
+				id, isString := item.(string)
+				if !isString {
+					ids = nil
+
+					break
+				}
+				ids = append(ids, id)
+			}
+		}
+
+		if len(ids) == 0 {
+			res.AddWarnings(FindingAt(pointer, invalidSuppressionMsg(name, "#"+pointer)))
+
+			return
+		}
+
+		for _, id := range ids {
+			suppressions = append(suppressions, &suppression{pointer: pointer, ruleID: id})
+		}
+	})
+
+	return suppressions
+}
+
+// covers tells if a finding at some location is about the object declaring the suppression, or about one of
+// its children. Findings which are not located are not covered.
+func (c *suppression) covers(location string) bool {
+	object := "#" + c.pointer
+
+	return location == object || strings.HasPrefix(location, object+"/")
+}
+
+// suppress removes from the findings of a rule the ones suppressed by x-validate-ignore.
+func (s *SpecValidator) suppress(id string, red *Result) {
+	if len(s.suppressions) == 0 {
+		return
+	}
+
+	isSuppressed := func(finding error) bool {
+		suppressed := false
+		for _, candidate := range s.suppressions {
+			if candidate.ruleID == id && candidate.covers(locationOf(finding)) {
+				candidate.used = true
+				suppressed = true
+			}
+		}
+
+		return suppressed
+	}
+
+	red.Errors = slices.DeleteFunc(red.Errors, isSuppressed)
+	red.Warnings = slices.DeleteFunc(red.Warnings, isSuppressed)
+	red.Infos = slices.DeleteFunc(red.Infos, isSuppressed)
+	red.Hints = slices.DeleteFunc(red.Hints, isSuppressed)
+}
+
+// validateSuppressions reports the suppressions which do not match any finding of a rule which has been run,
+// or which refer to an unknown rule.
+func (s *SpecValidator) validateSuppressions(run map[string]bool) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	for _, candidate := range s.suppressions {
+		switch {
+		case !slices.ContainsFunc(s.rules, func(registered *registeredRule) bool {
+			return registered.rule.ID() == candidate.ruleID
+		}):
+			res.AddWarnings(FindingAt(candidate.pointer, unknownSuppressedRuleMsg(xValidateIgnore, "#"+candidate.pointer, candidate.ruleID)))
+		case run[candidate.ruleID] && !candidate.used:
+			res.AddWarnings(FindingAt(candidate.pointer, staleSuppressionMsg(xValidateIgnore, "#"+candidate.pointer, candidate.ruleID)))
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_Suppressions(t *testing.T) {
+	document, err := loads.Spec(filepath.Join("fixtures", "validation", "fixture-suppressions.yaml"))
+	require.NoError(t, err)
+
+	validator := NewSpecValidator(document.Schema(), strfmt.Default)
+	validator.SetContinueOnErrors(true)
+	validator.Options.StrictExtensions = true
+	res, _ := validator.Validate(document)
+
+	errs := verifiedTestErrors(res)
+	warnings := verifiedTestWarnings(res)
+
+	t.Run("should suppress findings about an object", func(t *testing.T) {
+		assert.Empty(t, errs)
+		assert.SliceNotContainsT(t, warnings, `definition "#/definitions/Legacy" is not used anywhere`)
+		for _, warning := range warnings {
+			assert.NotContains(t, warning, "path /users/me is ambiguous")
+		}
+	})
+
+	t.Run("should not suppress findings about other objects", func(t *testing.T) {
+		assert.SliceContainsT(t, warnings, `definition "#/definitions/LegacyList" is not used anywhere`)
+		assert.SliceContainsT(t, warnings, `definition "#/definitions/Invalid" is not used anywhere`)
+	})
+
+	t.Run("should report stale suppressions", func(t *testing.T) {
+		assert.SliceContainsT(t, warnings,
+			`x-validate-ignore in #/definitions/User does not suppress any finding of rule "unused-references"`)
+		assert.SliceContainsT(t, warnings, `x-validate-ignore in #/definitions/User suppresses the unknown rule "no-such-rule"`)
+		assert.SliceContainsT(t, warnings, `x-validate-ignore in #/definitions/Invalid should be a rule ID or a list of rule IDs`)
+		assert.Len(t, warnings, 5)
+	})
+
+	t.Run("should not report suppressions of rules which are not run", func(t *testing.T) {
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		validator.DisableRule("unused-references")
+		res, _ := validator.Validate(document)
+
+		for _, warning := range verifiedTestWarnings(res) {
+			assert.NotContains(t, warning, "does not suppress any finding")
+		}
+	})
+}
+
+func TestSpec_SuppressionsByLocation(t *testing.T) {
+	const doc = `{
+		"swagger": "2.0",
+		%s
+		"info": {"title": "t", "version": "1"},
+		"paths": {
+			"/pets": {
+				"get": {
+					"operationId": "listPets",
+					"parameters": [{"name": "Accept", "in": "header", "type": "string", "x-validate-ignore": ["parameters"]}],
+					"responses": {"200": {"description": "ok"}}
+				}
+			}
+		},
+		"definitions": {
+			"Pet": {"type": "object"},
+			"PetList": {"type": "array", "items": {"type": "string"}}
+		}
+	}`
+
+	validate := func(t *testing.T, root string) []string {
+		t.Helper()
+
+		document, err := loads.Analyzed([]byte(fmt.Sprintf(doc, root)), "")
+		require.NoError(t, err)
+
+		validator := NewSpecValidator(document.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		res, _ := validator.Validate(document)
+		require.Empty(t, verifiedTestErrors(res))
+
+		return verifiedTestWarnings(res)
+	}
+
+	t.Run("should suppress findings which do not mention the object", func(t *testing.T) {
+		warnings := validate(t, "")
+
+		for _, warning := range warnings {
+			assert.NotContains(t, warning, "use produces instead")
+			assert.NotContains(t, warning, "does not suppress any finding")
+		}
+		assert.SliceContainsT(t, warnings, `definition "#/definitions/Pet" is not used anywhere`)
+	})
+
+	t.Run("should suppress findings about the whole spec at the root", func(t *testing.T) {
+		warnings := validate(t, `"x-validate-ignore": ["unused-references"],`)
+
+		for _, warning := range warnings {
+			assert.NotContains(t, warning, "is not used anywhere")
+			assert.NotContains(t, warning, "does not suppress any finding")
+		}
+	})
+}
+
+func TestSpec_SuppressionsOnMaps(t *testing.T) {
+	res, _ := loadFixtureAndValidate(t, "fixture-suppressions-on-maps.yaml")
+	require.Empty(t, verifiedTestErrors(res))
+
+	warnings := verifiedTestWarnings(res)
+	for _, warning := range warnings {
+		assert.NotContains(t, warning, "is ambiguous")
+		assert.NotContains(t, warning, "has no success response")
+		assert.NotContains(t, warning, "does not suppress any finding")
+	}
+	assert.Empty(t, warnings)
+}
+
+func TestSuppressionCovers(t *testing.T) {
+	object := &suppression{pointer: "/definitions/User", ruleID: "unused-references"}
+
+	assert.TrueT(t, object.covers("#/definitions/User"))
+	assert.TrueT(t, object.covers("#/definitions/User/properties/id"))
+	assert.FalseT(t, object.covers("#/definitions/UserList"))
+	assert.FalseT(t, object.covers("#/definitions"))
+	assert.FalseT(t, object.covers(""))
+
+	root := &suppression{pointer: "", ruleID: "unused-references"}
+
+	assert.TrueT(t, root.covers("#"))
+	assert.TrueT(t, root.covers("#/definitions/User"))
+	assert.FalseT(t, root.covers(""))
+}
@@ -6,6 +6,7 @@ package validate
 import (
 	"encoding/json"
 	"flag"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -398,6 +399,54 @@ func TestSpec_ValidateRequiredDefinitions(t *testing.T) {
 	assert.NotEmpty(t, res.Errors)
 }
 
+func TestSpec_ValidateRequiredDefinitionsWithComposition(t *testing.T) {
+	t.Run("should accept required properties defined anywhere in the composition", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-required-composition-good.yaml")
+		assert.TrueT(t, res.IsValid())
+		assert.SliceContainsT(t, verifiedTestWarnings(res), `Required property id in "dog" should not be marked as both required and readOnly`)
+	})
+
+	t.Run("should report required properties not provided by any schema in the composition", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-required-composition.yaml")
+		assert.FalseT(t, res.IsValid())
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs,
+			`"color" is present in required but not defined as property in definition "cat", nor in any of its allOf, anyOf or oneOf schemas`)
+		assert.SliceContainsT(t, errs,
+			`"meow" is present in required but not defined as property in definition "cat", nor in any of its allOf, anyOf or oneOf schemas`)
+		assert.Len(t, errs, 2)
+	})
+
+	t.Run("should resolve relative references against the spec location", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "pet.json"), []byte(`{
+			"type": "object",
+			"properties": {"name": {"type": "string"}}
+		}`), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "swagger.json"), []byte(`{
+			"swagger": "2.0",
+			"info": {"title": "t", "version": "1"},
+			"paths": {},
+			"definitions": {
+				"cat": {
+					"required": ["name", "color"],
+					"allOf": [{"$ref": "pet.json"}]
+				}
+			}
+		}`), 0o600))
+
+		doc, err := loads.Spec(filepath.Join(dir, "swagger.json"))
+		require.NoError(t, err)
+		validator := NewSpecValidator(doc.Schema(), strfmt.Default)
+		validator.SetContinueOnErrors(true)
+		res, _ := validator.Validate(doc)
+
+		assert.Equal(t, []string{
+			`"color" is present in required but not defined as property in definition "cat", nor in any of its allOf, anyOf or oneOf schemas`,
+		}, verifiedTestErrors(res))
+	})
+}
+
 func TestSpec_ValidateParameters(t *testing.T) {
 	validatorForDoc := func(doc *loads.Document) *SpecValidator {
 		// build a spec validator for some doc
@@ -457,14 +506,12 @@ func TestSpec_ValidateParameters(t *testing.T) {
 			require.NoError(t, err)
 			validator := validatorForDoc(doc)
 
+			// the forbidden anyOf is explained by the keyword-placement rule
 			res := validator.validateParameters()
-			require.Len(t, res.Errors, 2)
+			require.Len(t, res.Errors, 1)
 			assert.StringContainsT(t, res.Errors[0].Error(),
 				`"/pets.POST.parameters.pet" must validate one and only one schema (oneOf). Found none valid`,
 			)
-			assert.StringContainsT(t, res.Errors[1].Error(),
-				`/pets.POST.parameters.pet.schema.anyOf in body is a forbidden property`,
-			)
 		})
 		t.Run("with loads.Spec", func(t *testing.T) {
 			// loading like a regular user of this library
@@ -474,7 +521,7 @@ func TestSpec_ValidateParameters(t *testing.T) {
 			err = Spec(doc, strfmt.Default)
 			require.Error(t, err)
 			require.ErrorContains(t, err,
-				"definitions.newPet.anyOf in body is a forbidden property",
+				`in "definitions.newPet", keyword "anyOf" is not supported by Swagger 2.0 schemas`,
 			)
 		})
 
@@ -811,13 +858,16 @@ func TestSpec_Issue1341(t *testing.T) {
 // test go-swagger/go-swagger#1614 (circular refs).
 func Test_Issue1614(t *testing.T) {
 	path := filepath.Join("fixtures", "bugs", "1614", "gitea.json")
-	testIssue(t, path, 0, 3)
+	// 3 unused responses and definitions, 6 responses 204 declaring a schema, 7 ambiguous paths,
+	// 9 $ref to a response used as a schema, 1 body param on DELETE
+	testIssue(t, path, 0, 26)
 }
 
 // Test go-swagger/go-swagger#1621 (remote $ref).
 func Test_Issue1621(t *testing.T) {
 	path := filepath.Join("fixtures", "bugs", "1621", "fixture-1621.yaml")
-	testIssue(t, path, 0, 0)
+	// 21 operations declaring an Authorization header param
+	testIssue(t, path, 0, 21)
 }
 
 // Test go-swagger/go-swagger#1429 (remote $ref).
@@ -826,6 +876,37 @@ func Test_Issue1429(t *testing.T) {
 	testIssue(t, path, 0, 0)
 }
 
+func TestSpec_ValidateParamLocation(t *testing.T) {
+	res, _ := loadFixtureAndValidate(t, "fixture-param-location.yaml")
+	require.TrueT(t, res.IsValid())
+
+	warnings := verifiedTestWarnings(res)
+	for _, msg := range []string{
+		`in operation "getPets", path param "ids" is an array without collectionFormat: csv is assumed`,
+		`in operation "getPets", path param "owner" has a default value, which is never used since path params are always required`,
+		`owner in path has a default value and is required as parameter`,
+		`in operation "getPets", header param "Accept" should not be declared as a param: use produces instead`,
+		`in operation "getPets", header param "authorization" should not be declared as a param: use securityDefinitions instead`,
+		`in operation "getPets", body param "filter" is declared for method GET, which request body has no defined semantics`,
+		`in operation "addPet", header param "Content-Type" should not be declared as a param: use consumes instead`,
+	} {
+		assert.SliceContainsT(t, warnings, msg)
+	}
+	for _, msg := range warnings {
+		assert.FalseT(t, strings.Contains(msg, `"pet"`))
+	}
+
+	t.Run("should report parameters which cannot be declared in their location", func(t *testing.T) {
+		pr := spec.PathParam("id").Typed(objectType, "")
+		pr.AllowEmptyValue = true
+		res := paramHelp.checkParamLocation(pr, http.MethodGet, "getPet")
+
+		errs := verifiedTestErrors(res)
+		assert.SliceContainsT(t, errs, `in operation "getPet", param "id" in path may not declare allowEmptyValue: this is only valid for query and formData params`)
+		assert.SliceContainsT(t, errs, `in operation "getPet", path param "id" may not be of type object`)
+	})
+}
+
 func TestSpec_ValidationTypeMismatch(t *testing.T) {
 	doc, err := loads.Spec(filepath.Join("fixtures", "validation", "type-keyword-mismatch.yaml"))
 	require.NoError(t, err)
@@ -834,9 +915,10 @@ func TestSpec_ValidationTypeMismatch(t *testing.T) {
 	validator.analyzer = analysis.New(doc.Spec())
 	res := validator.validateParameters()
 	assert.NotEmpty(t, res.Warnings)
-	assert.Len(t, res.Warnings, 3)
+	assert.Len(t, res.Warnings, 4)
 
 	warnings := verifiedTestWarnings(res)
+	assert.SliceContainsT(t, warnings, `in operation "", path param "id" is an array without collectionFormat: csv is assumed`)
 	assert.SliceContainsT(t, warnings, `validation keywords of parameter "id" in path "/test/{id}/string" don't match its type string`)
 	assert.SliceContainsT(t, warnings, `validation keywords of parameter "id" in path "/test/{id}/integer" don't match its type integer`)
 	assert.SliceContainsT(t, warnings, `validation keywords of parameter "id" in path "/test/{id}/array" don't match its type array`)
@@ -858,18 +940,50 @@ func loadAndValidate(t testing.TB, fp string, early ...bool) (*Result, *Result)
 	return validator.Validate(doc)
 }
 
+// loadFixtureAndValidate validates a spec from fixtures/validation, with "ContinueOnErrors" enabled.
+func loadFixtureAndValidate(t testing.TB, fixture string, opts ...func(*Opts)) (*Result, *Result) {
+	doc, err := loads.Spec(filepath.Join("fixtures", "validation", fixture))
+	require.NoError(t, err)
+	require.NotNil(t, doc)
+	return validateWithOpts(doc, opts...)
+}
+
+// loadJSONAndValidate validates an in-memory JSON spec, with "ContinueOnErrors" enabled.
+//
+// Prefer a fixture with loadFixtureAndValidate, unless the spec is an edge case specific to the test.
+func loadJSONAndValidate(t testing.TB, jazon string, opts ...func(*Opts)) (*Result, *Result) {
+	doc, err := loads.Analyzed(json.RawMessage(jazon), "")
+	require.NoError(t, err)
+	require.NotNil(t, doc)
+	return validateWithOpts(doc, opts...)
+}
+
+func validateWithOpts(doc *loads.Document, opts ...func(*Opts)) (*Result, *Result) {
+	validator := NewSpecValidator(doc.Schema(), strfmt.Default)
+	validator.Options.ContinueOnErrors = true
+	for _, apply := range opts {
+		apply(&validator.Options)
+	}
+	return validator.Validate(doc)
+}
+
 func TestItemsProperty_Issue43(t *testing.T) {
-	for _, fixture := range []string{
-		"fixture-43.yaml",
-		"fixture-43-variants.yaml",
-		"fixture-1456.yaml",
+	for fixture, expectedWarnings := range map[string][]string{
+		"fixture-43.yaml": nil,
+		// body params are declared on GET operations
+		"fixture-43-variants.yaml": {
+			`in operation "", body param "itemsparam" is declared for method GET, which request body has no defined semantics`,
+			`in operation "", body param "typeparam" is declared for method GET, which request body has no defined semantics`,
+		},
+		"fixture-1456.yaml": nil,
 	} {
 		fp := filepath.Join("fixtures", "bugs", "43", fixture)
 		res, warnings := loadAndValidate(t, fp)
 		assert.TrueTf(t, res.IsValid(), "expected spec from %s to be valid", fixture)
 		assert.Emptyf(t, res.Errors, "expected no error in %s", fixture)
-		assert.Emptyf(t, res.Warnings, "expected no warning in %s", fixture)
-		assert.Emptyf(t, warnings, "expected no warning in %s", fixture)
+		assert.ElementsMatchf(t, expectedWarnings, verifiedTestWarnings(res), "unexpected warnings in %s", fixture)
+		// the warnings result reports warnings as errors
+		assert.ElementsMatchf(t, expectedWarnings, verifiedTestErrors(warnings), "unexpected warnings in %s", fixture)
 	}
 
 	fp := filepath.Join("fixtures", "bugs", "43", "fixture-43-fail.yaml")
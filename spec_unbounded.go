@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// xUnbounded is the vendor extension which exempts a parameter, a header or a schema,
+// and everything it contains, from the unbounded resource lint.
+const xUnbounded = "x-unbounded"
+
+// UnboundedScope tells which side of the operations is inspected by the unbounded resource lint.
+type UnboundedScope uint8
+
+// Scopes of the unbounded resource lint. The zero value disables the lint.
+const (
+	UnboundedInRequests UnboundedScope = 1 << iota
+	UnboundedInResponses
+
+	UnboundedEverywhere = UnboundedInRequests | UnboundedInResponses
+)
+
+// unboundedValidator reports the schemas which do not bound the size of the data they accept:
+//
+//   - arrays without maxItems
+//   - strings without maxLength, unless they declare a format or an enum
+//   - objects with additionalProperties and without maxProperties
+//   - numbers and integers without minimum or maximum, unless they declare an enum
+//
+// It is enabled by the UnboundedResourceLint option. Parameters, headers and schemas
+// declaring x-unbounded: true are exempted.
+type unboundedValidator struct {
+	SpecValidator  *SpecValidator
+	visitedSchemas map[string]struct{}
+}
+
+// Validate walks the operations of the expanded spec, like the defaultValidator, and reports unbounded request
+// parameters and bodies and/or unbounded response headers and bodies, depending on the UnboundedResourceLint option.
+func (u *unboundedValidator) Validate() *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	if u == nil || u.SpecValidator == nil {
+		return res
+	}
+
+	s := u.SpecValidator
+	scope := s.Options.UnboundedResourceLint
+	visitor := operationVisitor{}
+
+	if scope&UnboundedInRequests != 0 {
+		visitor.param = func(method, path string, op *spec.Operation, param *spec.Parameter) {
+			res.Merge(u.validateParam(operationName(method, path, op), param).locate(s.paramPointer(method, path, param)))
+		}
+	}
+
+	if scope&UnboundedInResponses != 0 {
+		visitor.response = func(method, path string, op *spec.Operation, response *spec.Response, responseType string, responseCode int) {
+			res.Merge(u.validateResponse(operationName(method, path, op), response, responseType, responseCode).
+				locate(responsePointer(method, path, responseType, responseCode)))
+		}
+	}
+
+	s.walkOperations(res, visitor)
+
+	return res
+}
+
+func (u *unboundedValidator) validateParam(operation string, param *spec.Parameter) *Result {
+	res := pools.poolOfResults.BorrowResult()
+	if isUnboundedExempt(param.Extensions) {
+		return res
+	}
+
+	report := func(where string, reasons []string) {
+		if len(reasons) > 0 {
+			res.AddWarnings(unboundedInRequestMsg(operation, where, param.In, strings.Join(reasons, ", ")))
+		}
+	}
+
+	if param.Schema != nil {
+		u.validateSchema(param.Name, param.Schema, report)
+
+		return res
+	}
+
+	report(param.Name, unboundedReasons(param.Type, param.Format, &param.CommonValidations))
+	validateUnboundedItems(param.Name, param.Items, report)
+
+	return res
+}
+
+func (u *unboundedValidator) validateResponse(operation string, response *spec.Response, responseType string, responseCode int) *Result {
+	res := pools.poolOfResults.BorrowResult()
+
+	responseName, _ := responseHelp.responseMsgVariants(responseType, responseCode)
+	report := func(where string, reasons []string) {
+		if len(reasons) > 0 {
+			res.AddWarnings(unboundedInResponseMsg(operation, where, responseName, strings.Join(reasons, ", ")))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(response.Headers)) {
+		header := response.Headers[name]
+		if isUnboundedExempt(header.Extensions) {
+			continue
+		}
+
+		where := "header " + name
+		report(where, unboundedReasons(header.Type, header.Format, &header.CommonValidations))
+		validateUnboundedItems(where, header.Items, report)
+	}
+
+	if response.Schema != nil {
+		u.validateSchema(swaggerBody, response.Schema, report)
+	}
+
+	return res
+}
+
+func validateUnboundedItems(path string, items *spec.Items, report func(string, []string)) {
+	for items != nil && !isUnboundedExempt(items.Extensions) {
+		path += ".items"
+		report(path, unboundedReasons(items.Type, items.Format, &items.CommonValidations))
+		items = items.Items
+	}
+}
+
+func (u *unboundedValidator) validateSchema(path string, schema *spec.Schema, report func(string, []string)) {
+	// reset explored schemas to get depth-first recursive-proof exploration
+	if u.visitedSchemas == nil {
+		u.visitedSchemas = make(map[string]struct{})
+	}
+	clear(u.visitedSchemas)
+
+	walkSchema(path, "", schema, u.visitedSchemas, func(path string, schema *spec.Schema) bool {
+		// $ref remaining in the expanded spec are recursive: the schemas they refer to are inspected once
+		if isUnboundedExempt(schema.Extensions) || schema.Ref.String() != "" {
+			return false
+		}
+
+		var reasons []string
+		validations := schema.Validations()
+		for _, typ := range schema.Type {
+			reasons = append(reasons, unboundedReasons(typ, schema.Format, &validations.CommonValidations)...)
+		}
+		if schema.AdditionalProperties != nil && (schema.AdditionalProperties.Allows || schema.AdditionalProperties.Schema != nil) &&
+			schema.MaxProperties == nil {
+			reasons = append(reasons, "object with additionalProperties and without maxProperties")
+		}
+		report(path, reasons)
+
+		return true
+	})
+}
+
+// operationName returns how an operation is named in messages: its operationId, or else its method and path.
+func operationName(method, path string, op *spec.Operation) string {
+	if op.ID != "" {
+		return op.ID
+	}
+
+	return fmt.Sprintf("%s %s", method, path)
+}
+
+// unboundedReasons explains why a value of some type is unbounded. It returns nil for bounded values.
+func unboundedReasons(typ, format string, validations *spec.CommonValidations) []string {
+	switch typ {
+	case arrayType:
+		if validations.MaxItems == nil {
+			return []string{"array without maxItems"}
+		}
+
+	case stringType:
+		if validations.MaxLength == nil && format == "" && len(validations.Enum) == 0 {
+			return []string{"string without maxLength"}
+		}
+
+	case numberType, integerType:
+		if len(validations.Enum) > 0 {
+			return nil
+		}
+
+		switch {
+		case validations.Minimum == nil && validations.Maximum == nil:
+			return []string{typ + " without minimum and maximum"}
+		case validations.Minimum == nil:
+			return []string{typ + " without minimum"}
+		case validations.Maximum == nil:
+			return []string{typ + " without maximum"}
+		}
+	}
+
+	return nil
+}
+
+// isUnboundedExempt tells if an object declares x-unbounded: true.
+func isUnboundedExempt(extensions spec.Extensions) bool {
+	value, ok := extensionValue(extensions, xUnbounded)
+	if !ok {
+		return false
+	}
+	exempt, isBool := value.(bool)
+
+	return isBool && exempt
+}
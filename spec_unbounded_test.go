@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestSpec_ValidateUnboundedResources(t *testing.T) {
+	t.Run("should not lint unbounded resources by default", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-unbounded.yaml")
+		require.TrueT(t, res.IsValid())
+		assert.Empty(t, res.Warnings)
+	})
+
+	t.Run("should warn about unbounded requests", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-unbounded.yaml", func(o *Opts) { o.UnboundedResourceLint = UnboundedInRequests })
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`in operation "getUsers", limit in request query is unbounded: integer without maximum`,
+			`in operation "getUsers", ids.items in request query is unbounded: string without maxLength`,
+			`in operation "addUser", user.labels in request body is unbounded: object with additionalProperties and without maxProperties`,
+			// recursive schemas are expanded once, then the remaining $ref is not followed
+			`in operation "addUser", user.manager.labels in request body is unbounded: object with additionalProperties and without maxProperties`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+	})
+
+	t.Run("should warn about unbounded responses", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-unbounded.yaml", func(o *Opts) { o.UnboundedResourceLint = UnboundedInResponses })
+		require.TrueT(t, res.IsValid())
+
+		warnings := verifiedTestWarnings(res)
+		expected := []string{
+			`in operation "getUsers", body in response 200 is unbounded: array without maxItems`,
+			`in operation "getUsers", body.items.labels in response 200 is unbounded: object with additionalProperties and without maxProperties`,
+			`in operation "getUsers", body.items.manager.labels in response 200 is unbounded: object with additionalProperties and without maxProperties`,
+		}
+		for _, msg := range expected {
+			assert.SliceContainsT(t, warnings, msg)
+		}
+		assert.Len(t, warnings, len(expected))
+	})
+
+	t.Run("should warn about both requests and responses", func(t *testing.T) {
+		res, _ := loadFixtureAndValidate(t, "fixture-unbounded.yaml", func(o *Opts) { o.UnboundedResourceLint = UnboundedEverywhere })
+		require.TrueT(t, res.IsValid())
+		assert.Len(t, res.Warnings, 7)
+	})
+}
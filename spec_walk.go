@@ -0,0 +1,428 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+// specKind is the kind of a node in a raw swagger document: an object defined by Swagger 2.0,
+// or a map or a list of such objects.
+type specKind uint8
+
+const (
+	specKindUnknown specKind = iota
+	specKindRoot
+	specKindInfo
+	specKindExternalDocs
+	specKindTags
+	specKindTag
+	specKindSecuritySchemes // securityDefinitions
+	specKindSecurityScheme
+	specKindPaths
+	specKindPathItem
+	specKindOperation
+	specKindParameters // parameters at the root level
+	specKindParamList  // parameters in a path item or operation
+	specKindParameter
+	specKindItems     // items of a parameter or of a header
+	specKindResponses // responses at the root level or in an operation
+	specKindResponse
+	specKindHeaders
+	specKindHeader
+	specKindSchemas     // definitions, properties
+	specKindSchemaList  // allOf
+	specKindSchemaItems // items in a schema: a schema or an array of schemas
+	specKindSchema
+)
+
+// child returns the kind of the node found under key.
+func (k specKind) child(key string) specKind {
+	switch k {
+	case specKindRoot:
+		switch key {
+		case "info":
+			return specKindInfo
+		case "externalDocs":
+			return specKindExternalDocs
+		case "tags":
+			return specKindTags
+		case "securityDefinitions":
+			return specKindSecuritySchemes
+		case "paths":
+			return specKindPaths
+		case "parameters":
+			return specKindParameters
+		case "responses":
+			return specKindResponses
+		case "definitions":
+			return specKindSchemas
+		}
+	case specKindTags:
+		return specKindTag
+	case specKindTag:
+		if key == "externalDocs" {
+			return specKindExternalDocs
+		}
+	case specKindSecuritySchemes:
+		return specKindSecurityScheme
+	case specKindPaths:
+		// the extensions of the paths object are not path items
+		if !isExtension(key) {
+			return specKindPathItem
+		}
+	case specKindPathItem:
+		if key == "parameters" {
+			return specKindParamList
+		}
+		if isOperationMethod(strings.ToUpper(key)) {
+			return specKindOperation
+		}
+	case specKindOperation:
+		switch key {
+		case "parameters":
+			return specKindParamList
+		case "responses":
+			return specKindResponses
+		case "externalDocs":
+			return specKindExternalDocs
+		}
+	case specKindParameters, specKindParamList:
+		return specKindParameter
+	case specKindParameter:
+		switch key {
+		case "schema":
+			return specKindSchema
+		case "items":
+			return specKindItems
+		}
+	case specKindItems, specKindHeader:
+		if key == "items" {
+			return specKindItems
+		}
+	case specKindResponses:
+		// the extensions of the responses object of an operation are not responses
+		if !isExtension(key) {
+			return specKindResponse
+		}
+	case specKindResponse:
+		switch key {
+		case "schema":
+			return specKindSchema
+		case "headers":
+			return specKindHeaders
+		}
+	case specKindHeaders:
+		return specKindHeader
+	case specKindSchemas, specKindSchemaList:
+		return specKindSchema
+	case specKindSchema:
+		switch key {
+		case jsonProperties:
+			return specKindSchemas
+		case "items":
+			return specKindSchemaItems
+		case "allOf":
+			return specKindSchemaList
+		case "additionalProperties":
+			return specKindSchema
+		case "externalDocs":
+			return specKindExternalDocs
+		}
+	case specKindSchemaItems:
+		if _, err := strconv.Atoi(key); err == nil {
+			return specKindSchema
+		}
+
+		return specKindSchema.child(key)
+	}
+
+	return specKindUnknown
+}
+
+// object returns the kind of the object found at a node: items in a schema hold a single schema,
+// unless they hold an array of schemas.
+func (k specKind) object() specKind {
+	if k == specKindSchemaItems {
+		return specKindSchema
+	}
+
+	return k
+}
+
+// isObject tells if a node of this kind is an object defined by Swagger 2.0, rather than a map of such objects.
+// The paths and responses objects are maps which may declare vendor extensions: they are objects as well.
+func (k specKind) isObject() bool {
+	switch k {
+	case specKindRoot, specKindInfo, specKindExternalDocs, specKindTag, specKindSecurityScheme,
+		specKindPaths, specKindPathItem, specKindOperation, specKindParameter, specKindItems,
+		specKindResponses, specKindResponse, specKindHeader, specKindSchema, specKindSchemaItems:
+		return true
+	default:
+		return false
+	}
+}
+
+// isList tells if a node of this kind may be an array of objects.
+func (k specKind) isList() bool {
+	return k == specKindTags || k == specKindParamList || k == specKindSchemaList || k == specKindSchemaItems
+}
+
+// walkSpec calls visit for every object defined by Swagger 2.0 in a raw swagger document, depth first and
+// in the order of the keys, with its JSON pointer and its kind. Items in a schema are visited as schemas.
+// The paths and responses objects are visited too, since they may declare vendor extensions.
+//
+// Visiting a node of another kind than specKindRoot walks only this part of the document, e.g. a schema.
+// The objects contained in an object are not visited when visit returns false.
+func walkSpec(node any, pointer string, kind specKind, visit func(pointer string, kind specKind, object map[string]any) bool) {
+	switch value := node.(type) {
+	case map[string]any:
+		if kind.isObject() && !visit(pointer, kind.object(), value) {
+			return
+		}
+
+		for _, key := range sortedKeys(value) {
+			if child := kind.child(key); child != specKindUnknown {
+				walkSpec(value[key], pointer+"/"+jsonpointer.Escape(key), child, visit)
+			}
+		}
+
+	case []any:
+		if !kind.isList() {
+			return
+		}
+
+		for i, item := range value {
+			walkSpec(item, pointer+"/"+strconv.Itoa(i), kind.child(strconv.Itoa(i)), visit)
+		}
+	}
+}
+
+// specKindAt returns the kind of the node designated by the tokens of a JSON pointer in a swagger document.
+func specKindAt(tokens []string) specKind {
+	kind := specKindRoot
+	for _, token := range tokens {
+		kind = kind.child(token)
+		if kind == specKindUnknown {
+			return kind
+		}
+	}
+
+	return kind.object()
+}
+
+// dottedPath returns the path of the node at some JSON pointer, as reported in messages, e.g. "definitions.Pet.properties.id".
+func dottedPath(pointer string) string {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = jsonpointer.Unescape(token)
+	}
+
+	return strings.Join(tokens, ".")
+}
+
+func isExtension(key string) bool {
+	return strings.HasPrefix(strings.ToLower(key), "x-")
+}
+
+// resolveLocalRef returns the object a raw node refers to with a $ref within the spec, e.g. "#/definitions/Pet",
+// or the node itself when it is not a $ref.
+func resolveLocalRef(doc, node any) (any, bool) {
+	ref, isRef := asObject(node)["$ref"].(string)
+	if !isRef {
+		return node, true
+	}
+
+	fragment, isLocal := strings.CutPrefix(ref, "#")
+	if !isLocal {
+		return nil, false
+	}
+
+	pointer, err := jsonpointer.New(fragment)
+	if err != nil {
+		return nil, false
+	}
+
+	target, _, err := pointer.Get(doc)
+	if err != nil {
+		return nil, false
+	}
+
+	return target, true
+}
+
+// operationVisitor receives the objects of the expanded spec found by walkOperations. Every callback is optional.
+type operationVisitor struct {
+	operation func(method, path string, op *spec.Operation)
+	param     func(method, path string, op *spec.Operation, param *spec.Parameter)
+
+	// response receives a resolved response, with its type (jsonDefault or "response") and its status code
+	response func(method, path string, op *spec.Operation, response *spec.Response, responseType string, responseCode int)
+}
+
+// walkOperations calls a visitor for every operation of the expanded spec, then for its parameters and its responses,
+// once resolved. Parameters and responses which cannot be resolved are reported in res.
+//
+// This is the walk of the validators which inspect the values declared along the operations, e.g. default values.
+func (s *SpecValidator) walkOperations(res *Result, visitor operationVisitor) {
+	for method, pathItem := range s.expandedAnalyzer().Operations() {
+		for path, op := range pathItem {
+			if visitor.operation != nil {
+				visitor.operation(method, path, op)
+			}
+
+			for _, param := range paramHelp.safeExpandedParamsFor(path, method, op.ID, res, s) {
+				if visitor.param != nil {
+					visitor.param(method, path, op, &param)
+				}
+			}
+
+			if op.Responses == nil {
+				continue
+			}
+
+			if op.Responses.Default != nil {
+				s.walkResponse(res, method, path, op, op.Responses.Default, jsonDefault, 0, visitor)
+			}
+			for _, code := range slices.Sorted(maps.Keys(op.Responses.StatusCodeResponses)) {
+				response := op.Responses.StatusCodeResponses[code]
+				s.walkResponse(res, method, path, op, &response, "response", code, visitor)
+			}
+		}
+	}
+}
+
+func (s *SpecValidator) walkResponse(res *Result, method, path string, op *spec.Operation, response *spec.Response,
+	responseType string, responseCode int, visitor operationVisitor,
+) {
+	resolved, red := responseHelp.expandResponseRef(response, path, s)
+	res.Merge(red.locate(responsePointer(method, path, responseType, responseCode)))
+	if resolved == nil || visitor.response == nil {
+		return
+	}
+
+	visitor.response(method, path, op, resolved, responseType, responseCode)
+}
+
+// walkSchema calls visit for a schema and, depth first, for the schemas it contains: items, additionalItems,
+// properties, patternProperties, additionalProperties and allOf. The schemas contained in a schema are not
+// visited when visit returns false.
+//
+// Paths already visited are skipped (see isVisited), so that recursive schemas are explored once. The paths of
+// items are suffixed with itemsSuffix, e.g. "body.items.default" when walking default values.
+func walkSchema(path, itemsSuffix string, schema *spec.Schema, visited map[string]struct{}, visit func(path string, schema *spec.Schema) bool) {
+	if schema == nil || isVisited(path, visited) {
+		return
+	}
+	visited[path] = struct{}{}
+
+	if !visit(path, schema) {
+		return
+	}
+
+	walk := func(childPath string, child *spec.Schema) {
+		walkSchema(childPath, itemsSuffix, child, visited, visit)
+	}
+
+	if schema.Items != nil {
+		if schema.Items.Schema != nil {
+			walk(path+".items"+itemsSuffix, schema.Items.Schema)
+		}
+		// Multiple schemas in items
+		for i := range schema.Items.Schemas {
+			walk(fmt.Sprintf("%s.items[%d]%s", path, i, itemsSuffix), &schema.Items.Schemas[i])
+		}
+	}
+	if schema.AdditionalItems != nil && schema.AdditionalItems.Schema != nil {
+		// NOTE: additionalItems is not supported by Swagger 2.0, but values declared there are still inspected
+		walk(path+".additionalItems", schema.AdditionalItems.Schema)
+	}
+	for _, propName := range slices.Sorted(maps.Keys(schema.Properties)) {
+		prop := schema.Properties[propName]
+		walk(path+"."+propName, &prop)
+	}
+	for _, propName := range slices.Sorted(maps.Keys(schema.PatternProperties)) {
+		prop := schema.PatternProperties[propName]
+		walk(path+"."+propName, &prop)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		walk(path+".additionalProperties", schema.AdditionalProperties.Schema)
+	}
+	for i := range schema.AllOf {
+		walk(fmt.Sprintf("%s.allOf[%d]", path, i), &schema.AllOf[i])
+	}
+}
+
+// pathPointer returns the JSON pointer to a path item.
+func pathPointer(path string) string {
+	return "/paths/" + jsonpointer.Escape(path)
+}
+
+// operationPointer returns the JSON pointer to an operation.
+func operationPointer(method, path string) string {
+	return pathPointer(path) + "/" + strings.ToLower(method)
+}
+
+// responsePointer returns the JSON pointer to a response of an operation, with its type (jsonDefault or "response")
+// and its status code.
+func responsePointer(method, path, responseType string, responseCode int) string {
+	if responseType == jsonDefault {
+		return operationPointer(method, path) + "/responses/default"
+	}
+
+	return operationPointer(method, path) + "/responses/" + strconv.Itoa(responseCode)
+}
+
+// definitionPointer returns the JSON pointer to a definition.
+func definitionPointer(name string) string {
+	return strings.TrimPrefix(definitionsPrefix, "#") + jsonpointer.Escape(name)
+}
+
+// paramPointer returns the JSON pointer to the declaration of a parameter of an operation: in the operation,
+// or else in its path item. A parameter declared with a $ref is located at the $ref.
+//
+// It returns the JSON pointer to the operation when the parameter is not declared there, e.g. when it cannot be resolved.
+func (s *SpecValidator) paramPointer(method, path string, param *spec.Parameter) string {
+	pointer := operationPointer(method, path)
+	if s.spec.Spec().Paths == nil {
+		return pointer
+	}
+
+	find := func(declaredAt string, declared []spec.Parameter) (string, bool) {
+		for i := range declared {
+			candidate := &declared[i]
+			if candidate.Ref.String() != "" {
+				resolved, err := spec.ResolveParameterWithBase(s.spec.Spec(), candidate.Ref, &spec.ExpandOptions{RelativeBase: s.spec.SpecFilePath()})
+				if err != nil {
+					continue
+				}
+				candidate = resolved
+			}
+
+			if candidate.Name == param.Name && candidate.In == param.In {
+				return declaredAt + "/parameters/" + strconv.Itoa(i), true
+			}
+		}
+
+		return "", false
+	}
+
+	if op, found := s.analyzer.OperationFor(method, path); found {
+		if declaredAt, isDeclared := find(pointer, op.Parameters); isDeclared {
+			return declaredAt
+		}
+	}
+	if declaredAt, isDeclared := find(pathPointer(path), s.spec.Spec().Paths.Paths[path].Parameters); isDeclared {
+		return declaredAt
+	}
+
+	return pointer
+}